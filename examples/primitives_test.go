@@ -0,0 +1,78 @@
+package examples
+
+import (
+	"computer_graphics/model"
+	"computer_graphics/obj/importer"
+	"computer_graphics/pngimage"
+	"fmt"
+	"math"
+)
+
+// The half size of the cross drawn for the point markers in pixels.
+const markerSize = 4
+
+// Draws all points and lines from the model using the z-buffer,
+// so that the faces drawn before cut off the parts behind them.
+// The points are drawn as small crosses.
+// The primitives are drawn in the diffuse colors of their materials or in the default color.
+func DrawPrimitivesZBuffer(m *model.Model, buffer [][]float64, img *pngimage.Image, rgb pngimage.RGB) {
+	var color = func(material *model.Material) pngimage.RGB {
+		if material == nil {
+			return rgb
+		}
+		return pngimage.NewRGB(material.Diffuse.R, material.Diffuse.G, material.Diffuse.B)
+	}
+	for i := 0; i < m.LinesCount(); i++ {
+		var line = m.GetLine(i)
+		for j := 1; j < line.VerticesCount(); j++ {
+			var v1, v2 = line.Vertex(j - 1), line.Vertex(j)
+			img.DepthLine(
+				int(math.Round(v1.X)), int(math.Round(v1.Y)), v1.Z,
+				int(math.Round(v2.X)), int(math.Round(v2.Y)), v2.Z,
+				buffer,
+				color(line.Material()),
+			)
+		}
+	}
+	for i := 0; i < m.PointsCount(); i++ {
+		var (
+			point = m.GetPoint(i)
+			v     = point.Vertex()
+			x     = int(math.Round(v.X))
+			y     = int(math.Round(v.Y))
+		)
+		img.DepthLine(x-markerSize, y, v.Z, x+markerSize, y, v.Z, buffer, color(point.Material()))
+		img.DepthLine(x, y-markerSize, v.Z, x, y+markerSize, v.Z, buffer, color(point.Material()))
+	}
+}
+
+// Draws all faces from testdata/guides.obj and the axis guides and markers cut off by them.
+func ExampleDrawPrimitivesZBuffer() {
+	var (
+		ipt    = importer.Importer{}
+		m, err = ipt.ImportFile("testdata/guides.obj")
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.Rotate(math.Pi/6, math.Pi/5, 0)
+	m.Transform(func(x, y, z float64) (float64, float64, float64) {
+		return 300*x + 500, -300*y + 500, 300*z + 500
+	})
+	var (
+		img    = pngimage.BlackImage(1000, 1000)
+		buffer = NewZBuffer(img)
+	)
+	BasicLightingZBuffer(m, buffer, img, pngimage.BlueColor())
+	DrawPrimitivesZBuffer(m, buffer, img, pngimage.RedColor())
+	fmt.Println("faces:", m.FacesCount(), "lines:", m.LinesCount(), "points:", m.PointsCount())
+	if err := img.Save("testdata/pictures/guides_z_buffer.png"); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println("Ok")
+	}
+	// Output:
+	// faces: 8 lines: 3 points: 6
+	// Ok
+}
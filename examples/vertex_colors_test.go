@@ -0,0 +1,115 @@
+package examples
+
+import (
+	"computer_graphics/mathutils"
+	"computer_graphics/model"
+	"computer_graphics/obj/importer"
+	"computer_graphics/pngimage"
+	"fmt"
+	"math"
+)
+
+// Returns the color of the vertex, or the default color if the vertex has no color.
+func vertexColor(v *model.Vertex, rgb model.Color) model.Color {
+	if v.Color == nil {
+		return rgb
+	}
+	return *v.Color
+}
+
+// Draws a triangle using the z-buffer to cut off overlapping faces,
+// interpolating the colors of the vertices and multiplying them by the brightness.
+// The vertices without a color are drawn in the default color.
+func DrawTriangleVertexColors(
+	v1, v2, v3 *model.Vertex,
+	buffer [][]float64,
+	img *pngimage.Image,
+	rgb model.Color,
+	brightness float64,
+) {
+	var (
+		xMax       = math.Min(float64(img.Width()), mathutils.Max(v1.X, v2.X, v3.X))
+		xMin       = math.Max(0, mathutils.Min(v1.X, v2.X, v3.X))
+		yMax       = math.Min(float64(img.Height()), mathutils.Max(v1.Y, v2.Y, v3.Y))
+		yMin       = math.Max(0, mathutils.Min(v1.Y, v2.Y, v3.Y))
+		c1         = vertexColor(v1, rgb)
+		c2         = vertexColor(v2, rgb)
+		c3         = vertexColor(v3, rgb)
+		l1, l2, l3 float64
+		x, y, z    float64
+	)
+	for i := int(math.Ceil(xMin)); float64(i) < xMax; i++ {
+		for j := int(math.Ceil(yMin)); float64(j) < yMax; j++ {
+			x = float64(i)
+			y = float64(j)
+			l1 = ((v2.X-v3.X)*(y-v3.Y) - (v2.Y-v3.Y)*(x-v3.X)) / ((v2.X-v3.X)*(v1.Y-v3.Y) - (v2.Y-v3.Y)*(v1.X-v3.X))
+			l2 = ((v3.X-v1.X)*(y-v1.Y) - (v3.Y-v1.Y)*(x-v1.X)) / ((v3.X-v1.X)*(v2.Y-v1.Y) - (v3.Y-v1.Y)*(v2.X-v1.X))
+			l3 = ((v1.X-v2.X)*(y-v2.Y) - (v1.Y-v2.Y)*(x-v2.X)) / ((v1.X-v2.X)*(v3.Y-v2.Y) - (v1.Y-v2.Y)*(v3.X-v2.X))
+			if l1 > 0 && l2 > 0 && l3 > 0 {
+				z = l1*v1.Z + l2*v2.Z + l3*v3.Z
+				if z < buffer[i][j] {
+					img.Set(i, j, pngimage.NewRGB(
+						brightness*(l1*c1.R+l2*c2.R+l3*c3.R),
+						brightness*(l1*c1.G+l2*c2.G+l3*c3.G),
+						brightness*(l1*c1.B+l2*c2.B+l3*c3.B),
+					))
+					buffer[i][j] = z
+				}
+			}
+		}
+	}
+}
+
+// Draws all faces from the model with the interpolated colors of their vertices,
+// darkening the faces that are rotated by a larger angle.
+// The vertices without a color are drawn in the default color.
+func VertexColorLighting(m *model.Model, img *pngimage.Image, rgb model.Color) {
+	var (
+		face       *model.Face
+		v1, v2, v3 model.Vertex
+		x, y, z    float64
+		cos        float64
+		buffer     = make([][]float64, img.Width())
+	)
+	for i := 0; i < img.Width(); i++ {
+		buffer[i] = make([]float64, img.Height())
+		for j := 0; j < img.Height(); j++ {
+			buffer[i][j] = math.Inf(+1)
+		}
+	}
+	for i := 0; i < m.FacesCount(); i++ {
+		face = m.GetFace(i)
+		x, y, z = face.Normal()
+		cos = z / math.Sqrt(x*x+y*y+z*z)
+		if cos < 0 {
+			v1 = face.Vertex1()
+			v2 = face.Vertex2()
+			v3 = face.Vertex3()
+			DrawTriangleVertexColors(&v1, &v2, &v3, buffer, img, rgb, -cos)
+		}
+	}
+}
+
+// Draws all faces from testdata/octahedron.obj with the colors of its vertices.
+func ExampleVertexColorLighting() {
+	var (
+		ipt    = importer.Importer{}
+		m, err = ipt.ImportFile("testdata/octahedron.obj")
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.Rotate(math.Pi/6, math.Pi/5, 0)
+	m.Transform(func(x, y, z float64) (float64, float64, float64) {
+		return 400*x + 500, -400*y + 500, 400*z + 500
+	})
+	var img = pngimage.BlackImage(1000, 1000)
+	VertexColorLighting(m, img, model.Color{R: 1, G: 1, B: 1})
+	if err := img.Save("testdata/pictures/octahedron_vertex_colors.png"); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println("Ok")
+	}
+	// Output: Ok
+}
@@ -38,21 +38,31 @@ func DrawTriangleZBuffer(v1, v2, v3 *model.Vertex, buffer [][]float64, img *pngi
 	}
 }
 
+// Creates a z-buffer for the image, filled with the infinite depth.
+func NewZBuffer(img *pngimage.Image) [][]float64 {
+	var buffer = make([][]float64, img.Width())
+	for i := 0; i < img.Width(); i++ {
+		buffer[i] = make([]float64, img.Height())
+		for j := 0; j < img.Height(); j++ {
+			buffer[i][j] = math.Inf(+1)
+		}
+	}
+	return buffer
+}
+
 // Draws all faces from the model, darkening the faces that are rotated by a larger angle.
 func BasicLighting(m *model.Model, img *pngimage.Image, rgb pngimage.RGB) {
+	BasicLightingZBuffer(m, NewZBuffer(img), img, rgb)
+}
+
+// Draws all faces from the model using the specified z-buffer, darkening the faces that are rotated by a larger angle.
+func BasicLightingZBuffer(m *model.Model, buffer [][]float64, img *pngimage.Image, rgb pngimage.RGB) {
 	var (
 		face       *model.Face
 		v1, v2, v3 model.Vertex
 		x, y, z    float64
 		cos        float64
-		buffer     = make([][]float64, img.Width())
 	)
-	for i := 0; i < img.Width(); i++ {
-		buffer[i] = make([]float64, img.Height())
-		for j := 0; j < img.Height(); j++ {
-			buffer[i][j] = math.Inf(+1)
-		}
-	}
 	for i := 0; i < m.FacesCount(); i++ {
 		face = m.GetFace(i)
 		x, y, z = face.Normal()
@@ -124,3 +134,74 @@ func ExampleBasicLighting_fox() {
 	}
 	// Output: Ok
 }
+
+// Draws all faces from the model in the diffuse colors of their materials,
+// darkening the faces that are rotated by a larger angle.
+// The faces without a material are drawn in the default color.
+func MaterialLighting(m *model.Model, img *pngimage.Image, rgb pngimage.RGB) {
+	var (
+		face       *model.Face
+		v1, v2, v3 model.Vertex
+		x, y, z    float64
+		cos        float64
+		color      pngimage.RGB
+		buffer     = make([][]float64, img.Width())
+	)
+	for i := 0; i < img.Width(); i++ {
+		buffer[i] = make([]float64, img.Height())
+		for j := 0; j < img.Height(); j++ {
+			buffer[i][j] = math.Inf(+1)
+		}
+	}
+	for i := 0; i < m.FacesCount(); i++ {
+		face = m.GetFace(i)
+		x, y, z = face.Normal()
+		cos = z / math.Sqrt(x*x+y*y+z*z)
+		if cos < 0 {
+			color = rgb
+			if material := face.Material(); material != nil {
+				color = pngimage.RGB{
+					R: uint8(255 * material.Diffuse.R),
+					G: uint8(255 * material.Diffuse.G),
+					B: uint8(255 * material.Diffuse.B),
+				}
+			}
+			v1 = face.Vertex1()
+			v2 = face.Vertex2()
+			v3 = face.Vertex3()
+			DrawTriangleZBuffer(
+				&v1,
+				&v2,
+				&v3,
+				buffer,
+				img,
+				pngimage.RGB{
+					R: uint8(-float64(color.R) * cos),
+					G: uint8(-float64(color.G) * cos),
+					B: uint8(-float64(color.B) * cos),
+				},
+			)
+		}
+	}
+}
+
+// Draws all faces from testdata/fox.obj in the color of the material from testdata/low-poly-fox-by-pixelmannen.mtl.
+func ExampleMaterialLighting_fox() {
+	var (
+		ipt    = importer.Importer{}
+		m, err = ipt.ImportFile("testdata/fox.obj")
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.Transform(defaultFoxTransformation)
+	var img = pngimage.BlackImage(1000, 1000)
+	MaterialLighting(m, img, pngimage.WhiteColor())
+	if err := img.Save("testdata/pictures/fox_materials.png"); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println("Ok")
+	}
+	// Output: Ok
+}
@@ -1 +1,3 @@
-module "computer_graphics"
\ No newline at end of file
+module "computer_graphics"
+
+go 1.18
@@ -0,0 +1,198 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Describes a vertex in the texture space.
+// Contains the horizontal and vertical directions of the texture: U, V, and the depth of the texture: W.
+type TextureVertex struct {
+	U, V, W float64
+}
+
+// Describes a normal vector of a vertex with components I, J and K.
+type Normal struct {
+	I, J, K float64
+}
+
+// Describes a corner of a face: the references to its vertex, texture vertex and normal.
+// The indices follow the rules of the .obj format: the index of the first element is 1,
+// the negative indices count from the last added element.
+// The value 0 of Texture or Normal means that the texture vertex or normal is not specified.
+type Corner struct {
+	Vertex, Texture, Normal int
+}
+
+// Describes the problems with the indices of the corners of a face added to the model.
+// Each kind of the indices is checked separately.
+type FaceError struct {
+	Vertex  error // The error of the vertex indices, the face is not added if it is not nil.
+	Texture error // The error of the texture vertex indices, the face is added without the texture vertices.
+	Normal  error // The error of the normal indices, the face is added without the normals.
+}
+
+// Implementation of the Error method in the error interface.
+func (e *FaceError) Error() string {
+	var messages []string
+	for _, err := range []error{e.Vertex, e.Texture, e.Normal} {
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+	return strings.Join(messages, ", ")
+}
+
+// Returns the position of an element in the list by its index and an error if the index is specified incorrectly.
+// Supports negative indexing, the index of the first element is 1.
+// The name of the element is used in the error message.
+func resolveIndex(index, count int, name string) (int, error) {
+	switch {
+	case index > 0 && index <= count:
+		return index - 1, nil
+	case index < 0 && -index <= count:
+		return count + index, nil
+	case index == 0:
+		return 0, fmt.Errorf("%s index cannot be zero", name)
+	default:
+		return 0, fmt.Errorf("unresolved %s index: %d", name, index)
+	}
+}
+
+// Adds a texture vertex to the model based on its three coordinates.
+func (model *Model) AppendTextureVertex(u, v, w float64) {
+	model.textures = append(model.textures, &TextureVertex{U: u, V: v, W: w})
+}
+
+// Returns the texture vertex of the model by index and an error if the index is specified incorrectly.
+// Supports negative indexing, the index of the first texture vertex is 1.
+func (model *Model) GetTextureVertex(index int) (TextureVertex, error) {
+	var position, err = resolveIndex(index, len(model.textures), "texture vertex")
+	if err != nil {
+		return TextureVertex{}, err
+	}
+	return *model.textures[position], nil
+}
+
+// Returns the number of model texture vertices.
+func (model *Model) TextureVerticesCount() int {
+	return len(model.textures)
+}
+
+// Adds a vertex normal to the model based on its three components.
+func (model *Model) AppendNormal(i, j, k float64) {
+	model.normals = append(model.normals, &Normal{I: i, J: j, K: k})
+}
+
+// Returns the vertex normal of the model by index and an error if the index is specified incorrectly.
+// Supports negative indexing, the index of the first normal is 1.
+func (model *Model) GetNormal(index int) (Normal, error) {
+	var position, err = resolveIndex(index, len(model.normals), "normal")
+	if err != nil {
+		return Normal{}, err
+	}
+	return *model.normals[position], nil
+}
+
+// Returns the number of model vertex normals.
+func (model *Model) NormalsCount() int {
+	return len(model.normals)
+}
+
+// Returns the texture vertices of the corners.
+// If the texture vertices are not specified for all the corners, nil values are returned without an error.
+// If one of the indices is specified incorrectly, nil values are returned with the error.
+func (model *Model) cornerTextures(corners [3]Corner) ([3]*TextureVertex, error) {
+	var res [3]*TextureVertex
+	if corners[0].Texture == 0 && corners[1].Texture == 0 && corners[2].Texture == 0 {
+		return res, nil
+	}
+	for i, c := range corners {
+		var position, err = resolveIndex(c.Texture, len(model.textures), "texture vertex")
+		if err != nil {
+			return [3]*TextureVertex{}, err
+		}
+		res[i] = model.textures[position]
+	}
+	return res, nil
+}
+
+// Returns the normals of the corners.
+// If the normals are not specified for all the corners, nil values are returned without an error.
+// If one of the indices is specified incorrectly, nil values are returned with the error.
+func (model *Model) cornerNormals(corners [3]Corner) ([3]*Normal, error) {
+	var res [3]*Normal
+	if corners[0].Normal == 0 && corners[1].Normal == 0 && corners[2].Normal == 0 {
+		return res, nil
+	}
+	for i, c := range corners {
+		var position, err = resolveIndex(c.Normal, len(model.normals), "normal")
+		if err != nil {
+			return [3]*Normal{}, err
+		}
+		res[i] = model.normals[position]
+	}
+	return res, nil
+}
+
+// Returns the corners of all the faces of the model in the order of the faces.
+// The corners contain the absolute indices of the vertices, texture vertices and normals, the index of the first element is 1.
+// The value 0 of Texture or Normal means that the texture vertices or normals of the face are not specified.
+func (model *Model) FaceCorners() [][3]Corner {
+	var (
+		vertices = model.vertexIndices()
+		textures = make(map[*TextureVertex]int, len(model.textures))
+		normals  = make(map[*Normal]int, len(model.normals))
+		res      = make([][3]Corner, len(model.faces))
+	)
+	for i, t := range model.textures {
+		textures[t] = i + 1
+	}
+	for i, n := range model.normals {
+		normals[n] = i + 1
+	}
+	for i, f := range model.faces {
+		for j, v := range [3]*Vertex{f.vertex1, f.vertex2, f.vertex3} {
+			res[i][j] = Corner{Vertex: vertices[v], Texture: textures[f.textures[j]], Normal: normals[f.normals[j]]}
+		}
+	}
+	return res
+}
+
+// Returns the absolute indices of the vertices of all the points of the model in the order of the points,
+// the index of the first vertex is 1.
+func (model *Model) PointVertices() []int {
+	var (
+		vertices = model.vertexIndices()
+		res      = make([]int, len(model.points))
+	)
+	for i, p := range model.points {
+		res[i] = vertices[p.vertex]
+	}
+	return res
+}
+
+// Returns the absolute indices of the vertices of all the lines of the model in the order of the lines,
+// the index of the first vertex is 1.
+func (model *Model) LineVertices() [][]int {
+	var (
+		vertices = model.vertexIndices()
+		res      = make([][]int, len(model.lines))
+	)
+	for i, l := range model.lines {
+		res[i] = make([]int, len(l.vertices))
+		for j, v := range l.vertices {
+			res[i][j] = vertices[v]
+		}
+	}
+	return res
+}
+
+// Returns the absolute indices of the vertices of the model by the pointers to them.
+func (model *Model) vertexIndices() map[*Vertex]int {
+	var res = make(map[*Vertex]int, len(model.vertices))
+	for i, v := range model.vertices {
+		res[v] = i + 1
+	}
+	return res
+}
@@ -0,0 +1,19 @@
+package model
+
+// Describes a color in RGB format, each component takes values from 0 to 1.
+type Color struct {
+	R, G, B float64
+}
+
+// Describes the appearance of the faces of the model.
+type Material struct {
+	Name             string  // The name of the material.
+	Ambient          Color   // The color of the face lit by ambient light.
+	Diffuse          Color   // The color of the face lit by diffuse light.
+	Specular         Color   // The color of the specular highlights.
+	Emissive         Color   // The color emitted by the face.
+	SpecularExponent float64 // The focus of the specular highlights.
+	Dissolve         float64 // The opacity of the face, 1 is fully opaque.
+	Illumination     int     // The number of the illumination model.
+	DiffuseMap       string  // The path to the texture file of the diffuse color, empty if not specified.
+}
@@ -2,14 +2,14 @@ package model
 
 import (
 	"errors"
-	"fmt"
 	"math"
 )
 
 // Describes a vertex in three-dimensional space.
-// Contains three coordinates of the vertex: X, Y, Z.
+// Contains three coordinates of the vertex: X, Y, Z and its optional color.
 type Vertex struct {
 	X, Y, Z float64
+	Color   *Color // The color of the vertex, nil if not specified.
 }
 
 // Creates a Vertex based on its three coordinates.
@@ -18,9 +18,16 @@ func NewVertex(x, y, z float64) *Vertex {
 }
 
 // Describes a triangle in three-dimensional space.
-// Contains three vertices of the triangle.
+// Contains three vertices of the triangle, the optional texture vertices and normals of its corners
+// and the names of the parts of the model it belongs to.
 type Face struct {
 	vertex1, vertex2, vertex3 *Vertex
+	textures                  [3]*TextureVertex // The texture vertices of the corners, nil if not specified.
+	normals                   [3]*Normal        // The normals of the corners, nil if not specified.
+	groups                    []string          // The names of the groups the triangle belongs to.
+	object                    string            // The name of the object the triangle belongs to, empty if not specified.
+	smoothingGroup            int               // The number of the smoothing group, 0 if the triangle is not smoothed.
+	material                  *Material         // The material of the triangle, nil if not specified.
 }
 
 // Returns the first vertex of the triangle.
@@ -38,6 +45,49 @@ func (f *Face) Vertex3() Vertex {
 	return *f.vertex3
 }
 
+// Returns the texture vertex of the corner of the triangle, the corners are numbered from 1 to 3.
+// Returns false if the texture vertices of the triangle are not specified.
+func (f *Face) Texture(corner int) (TextureVertex, bool) {
+	if f.textures[corner-1] == nil {
+		return TextureVertex{}, false
+	}
+	return *f.textures[corner-1], true
+}
+
+// Returns the normal of the corner of the triangle, the corners are numbered from 1 to 3.
+// Returns false if the normals of the triangle are not specified.
+func (f *Face) VertexNormal(corner int) (Normal, bool) {
+	if f.normals[corner-1] == nil {
+		return Normal{}, false
+	}
+	return *f.normals[corner-1], true
+}
+
+// Returns the names of the groups the triangle belongs to.
+func (f *Face) Groups() []string {
+	return f.groups
+}
+
+// Returns true if the triangle belongs to the group with the specified name.
+func (f *Face) InGroup(name string) bool {
+	return inGroup(f.groups, name)
+}
+
+// Returns the name of the object the triangle belongs to, empty if the object is not specified.
+func (f *Face) Object() string {
+	return f.object
+}
+
+// Returns the number of the smoothing group of the triangle, 0 if the triangle is not smoothed.
+func (f *Face) SmoothingGroup() int {
+	return f.smoothingGroup
+}
+
+// Returns the material of the triangle, nil if the material is not specified.
+func (f *Face) Material() *Material {
+	return f.material
+}
+
 // Calculates the normal to the surface of the triangle.
 func (f *Face) Normal() (float64, float64, float64) {
 	var (
@@ -51,40 +101,62 @@ func (f *Face) Normal() (float64, float64, float64) {
 	return x, y, z
 }
 
-// Creates a Face based on its three vertices.
-func newFace(vertex1, vertex2, vertex3 *Vertex) *Face {
+// Creates a Face based on its three vertices, the parts of the model it belongs to and its material.
+func newFace(
+	vertex1, vertex2, vertex3 *Vertex,
+	groups []string,
+	object string,
+	smoothingGroup int,
+	material *Material,
+) *Face {
 	return &Face{
-		vertex1: vertex1,
-		vertex2: vertex2,
-		vertex3: vertex3,
+		vertex1:        vertex1,
+		vertex2:        vertex2,
+		vertex3:        vertex3,
+		groups:         groups,
+		object:         object,
+		smoothingGroup: smoothingGroup,
+		material:       material,
 	}
 }
 
+// The name of the group the faces belong to if no group is specified.
+const DefaultGroup = "default"
+
+// The names of the object and the material that reset them in the o and usemtl statements,
+// since there are no statements that reset the object and the material in the .obj format.
+// The faces following the statements with these names do not belong to an object and have no material.
+const (
+	NoObject   = "(null)"
+	NoMaterial = "(null)"
+)
+
 // Describes a complete three-dimensional model.
+// In addition to the faces, the model can contain points and lines, such as markers and wire guides.
+// The faces of the model can be divided into named groups and objects.
+// The groups, object, smoothing group and material set by the SetGroups, SetObject, SetSmoothingGroup
+// and SetMaterial methods are assigned to all the faces, points and lines added after them.
 type Model struct {
-	vertices []*Vertex // A list of all the vertices of the model.
-	faces    []*Face   // A list of all the faces of the model.
+	vertices       []*Vertex        // A list of all the vertices of the model.
+	textures       []*TextureVertex // A list of all the texture vertices of the model.
+	normals        []*Normal        // A list of all the vertex normals of the model.
+	faces          []*Face          // A list of all the faces of the model.
+	points         []*Point         // A list of all the points of the model.
+	lines          []*Line          // A list of all the lines of the model.
+	groups         []string         // The groups assigned to the added faces.
+	object         string           // The object assigned to the added faces.
+	smoothingGroup int              // The smoothing group assigned to the added faces.
+	material       *Material        // The material assigned to the added faces.
 }
 
 // Returns a pointer to a vertex by its index and an error if the index is specified incorrectly.
 // Supports negative indexing, the index of the first vertex is 1.
 func (model *Model) vertexByIndex(index int) (*Vertex, error) {
-	var verticesCount = len(model.vertices)
-	if index > 0 {
-		if index <= verticesCount {
-			return model.vertices[index-1], nil
-		} else {
-			return nil, fmt.Errorf("unresolved vertex index: %d", index)
-		}
-	} else if index < 0 {
-		if -index <= verticesCount {
-			return model.vertices[verticesCount+index], nil
-		} else {
-			return nil, fmt.Errorf("unresolved vertex index: %d", index)
-		}
-	} else {
-		return nil, errors.New("vertex index cannot be zero")
+	var position, err = resolveIndex(index, len(model.vertices), "vertex")
+	if err != nil {
+		return nil, err
 	}
+	return model.vertices[position], nil
 }
 
 // Adds a vertex to the model based on its three coordinates.
@@ -92,11 +164,21 @@ func (model *Model) AppendVertex(x, y, z float64) {
 	model.vertices = append(model.vertices, NewVertex(x, y, z))
 }
 
+// Adds a vertex to the model based on its three coordinates and its color.
+func (model *Model) AppendColoredVertex(x, y, z float64, color Color) {
+	var v = NewVertex(x, y, z)
+	v.Color = &color
+	model.vertices = append(model.vertices, v)
+}
+
 // Returns the vertex of the model by index and an error if the index is specified incorrectly.
 // Supports negative indexing, the index of the first vertex is 1.
 func (model *Model) GetVertex(index int) (Vertex, error) {
 	var v, err = model.vertexByIndex(index)
-	return *v, err
+	if err != nil {
+		return Vertex{}, err
+	}
+	return *v, nil
 }
 
 // Returns the number of model vertices.
@@ -121,10 +203,268 @@ func (model *Model) AppendFace(v1, v2, v3 int) error {
 	if vertex3, err = model.vertexByIndex(v3); err != nil {
 		return err
 	}
-	model.faces = append(model.faces, newFace(vertex1, vertex2, vertex3))
+	model.faces = append(
+		model.faces,
+		newFace(vertex1, vertex2, vertex3, model.groups, model.object, model.smoothingGroup, model.material),
+	)
+	return nil
+}
+
+// Adds a face to the model based on its three corners.
+// The face is not added if one of the vertex indices is specified incorrectly.
+// If one of the texture vertex or normal indices is specified incorrectly,
+// the face is added without the texture vertices or normals respectively.
+// In both cases the returned error is a *FaceError describing the problems with each kind of the indices.
+func (model *Model) AppendFaceCorners(c1, c2, c3 Corner) error {
+	var (
+		res      FaceError
+		vertices [3]*Vertex
+		corners  = [3]Corner{c1, c2, c3}
+	)
+	for i, c := range corners {
+		if vertices[i], res.Vertex = model.vertexByIndex(c.Vertex); res.Vertex != nil {
+			return &res
+		}
+	}
+	var f = newFace(vertices[0], vertices[1], vertices[2], model.groups, model.object, model.smoothingGroup, model.material)
+	f.textures, res.Texture = model.cornerTextures(corners)
+	f.normals, res.Normal = model.cornerNormals(corners)
+	model.faces = append(model.faces, f)
+	if res.Texture != nil || res.Normal != nil {
+		return &res
+	}
+	return nil
+}
+
+// Adds a point to the model based on its vertex.
+func (model *Model) AppendPoint(v int) error {
+	var vertex, err = model.vertexByIndex(v)
+	if err != nil {
+		return err
+	}
+	model.points = append(
+		model.points,
+		&Point{vertex: vertex, groups: model.groups, object: model.object, material: model.material},
+	)
+	return nil
+}
+
+// Returns the point of the model by index.
+func (model *Model) GetPoint(index int) *Point {
+	return model.points[index]
+}
+
+// Returns the number of model points.
+func (model *Model) PointsCount() int {
+	return len(model.points)
+}
+
+// Adds a line to the model based on its vertices, at least two vertices are required.
+func (model *Model) AppendLine(vs ...int) error {
+	if len(vs) < 2 {
+		return errors.New("a line must contain at least two vertices")
+	}
+	var vertices = make([]*Vertex, len(vs))
+	for i, v := range vs {
+		var vertex, err = model.vertexByIndex(v)
+		if err != nil {
+			return err
+		}
+		vertices[i] = vertex
+	}
+	model.lines = append(
+		model.lines,
+		&Line{vertices: vertices, groups: model.groups, object: model.object, material: model.material},
+	)
 	return nil
 }
 
+// Returns the line of the model by index.
+func (model *Model) GetLine(index int) *Line {
+	return model.lines[index]
+}
+
+// Returns the number of model lines.
+func (model *Model) LinesCount() int {
+	return len(model.lines)
+}
+
+// Sets the groups that will be assigned to all the faces added after the call.
+// If no names are specified, the faces will belong to the DefaultGroup.
+func (model *Model) SetGroups(names ...string) {
+	if len(names) == 0 {
+		model.groups = []string{DefaultGroup}
+		return
+	}
+	model.groups = append([]string(nil), names...)
+}
+
+// Sets the object that will be assigned to all the faces added after the call.
+func (model *Model) SetObject(name string) {
+	model.object = name
+}
+
+// Sets the smoothing group that will be assigned to all the faces added after the call.
+// The value 0 turns off smoothing.
+func (model *Model) SetSmoothingGroup(number int) {
+	model.smoothingGroup = number
+}
+
+// Sets the material that will be assigned to all the faces added after the call.
+// The value nil means that the material is not specified.
+func (model *Model) SetMaterial(material *Material) {
+	model.material = material
+}
+
+// Returns all the materials of the faces of the model in the order of their first appearance.
+func (model *Model) Materials() []*Material {
+	var (
+		res  []*Material
+		seen = make(map[*Material]bool)
+	)
+	for _, f := range model.faces {
+		if f.material != nil && !seen[f.material] {
+			seen[f.material] = true
+			res = append(res, f.material)
+		}
+	}
+	return res
+}
+
+// Returns the names of all the groups the faces of the model belong to in the order of their first appearance.
+func (model *Model) Groups() []string {
+	var (
+		res  []string
+		seen = make(map[string]bool)
+	)
+	for _, f := range model.faces {
+		for _, group := range f.groups {
+			if !seen[group] {
+				seen[group] = true
+				res = append(res, group)
+			}
+		}
+	}
+	return res
+}
+
+// Returns the names of all the objects the faces of the model belong to in the order of their first appearance.
+// Faces without an object are not taken into account.
+func (model *Model) Objects() []string {
+	var (
+		res  []string
+		seen = make(map[string]bool)
+	)
+	for _, f := range model.faces {
+		if f.object != "" && !seen[f.object] {
+			seen[f.object] = true
+			res = append(res, f.object)
+		}
+	}
+	return res
+}
+
+// Creates a new model containing only the faces for which the predicate returns true.
+// The vertices, texture vertices and normals of these faces are copied, so the new model can be transformed independently.
+// The points and lines are not copied.
+func (model *Model) Filter(predicate func(f *Face) bool) *Model {
+	return model.filter(predicate, func([]string, string) bool { return false })
+}
+
+// Creates a new model containing only the faces for which the predicate returns true
+// and the points and lines whose groups and object satisfy the parts predicate.
+// The vertices of these elements are copied, so the new model can be transformed independently.
+func (model *Model) filter(predicate func(f *Face) bool, parts func(groups []string, object string) bool) *Model {
+	var (
+		res    = NewModel()
+		copies = make(map[*Vertex]*Vertex)
+	)
+	var vertexCopy = func(v *Vertex) *Vertex {
+		var c, ok = copies[v]
+		if !ok {
+			c = NewVertex(v.X, v.Y, v.Z)
+			if v.Color != nil {
+				var color = *v.Color
+				c.Color = &color
+			}
+			copies[v] = c
+			res.vertices = append(res.vertices, c)
+		}
+		return c
+	}
+	var (
+		textureCopies = make(map[*TextureVertex]*TextureVertex)
+		normalCopies  = make(map[*Normal]*Normal)
+	)
+	for _, f := range model.faces {
+		if predicate(f) {
+			var c = newFace(
+				vertexCopy(f.vertex1),
+				vertexCopy(f.vertex2),
+				vertexCopy(f.vertex3),
+				f.groups,
+				f.object,
+				f.smoothingGroup,
+				f.material,
+			)
+			for i := range f.textures {
+				if t := f.textures[i]; t != nil {
+					if textureCopies[t] == nil {
+						textureCopies[t] = &TextureVertex{U: t.U, V: t.V, W: t.W}
+						res.textures = append(res.textures, textureCopies[t])
+					}
+					c.textures[i] = textureCopies[t]
+				}
+				if n := f.normals[i]; n != nil {
+					if normalCopies[n] == nil {
+						normalCopies[n] = &Normal{I: n.I, J: n.J, K: n.K}
+						res.normals = append(res.normals, normalCopies[n])
+					}
+					c.normals[i] = normalCopies[n]
+				}
+			}
+			res.faces = append(res.faces, c)
+		}
+	}
+	for _, p := range model.points {
+		if parts(p.groups, p.object) {
+			res.points = append(
+				res.points,
+				&Point{vertex: vertexCopy(p.vertex), groups: p.groups, object: p.object, material: p.material},
+			)
+		}
+	}
+	for _, l := range model.lines {
+		if parts(l.groups, l.object) {
+			var vertices = make([]*Vertex, len(l.vertices))
+			for i, v := range l.vertices {
+				vertices[i] = vertexCopy(v)
+			}
+			res.lines = append(
+				res.lines,
+				&Line{vertices: vertices, groups: l.groups, object: l.object, material: l.material},
+			)
+		}
+	}
+	return res
+}
+
+// Creates a new model containing only the faces, points and lines belonging to the group with the specified name.
+func (model *Model) Group(name string) *Model {
+	return model.filter(
+		func(f *Face) bool { return f.InGroup(name) },
+		func(groups []string, _ string) bool { return inGroup(groups, name) },
+	)
+}
+
+// Creates a new model containing only the faces, points and lines belonging to the object with the specified name.
+func (model *Model) Object(name string) *Model {
+	return model.filter(
+		func(f *Face) bool { return f.object == name },
+		func(_ []string, object string) bool { return object == name },
+	)
+}
+
 // Returns the vertex of the model by index.
 func (model *Model) GetFace(index int) *Face {
 	return model.faces[index]
@@ -176,9 +516,11 @@ func (model *Model) Rotate(xAngle, yAngle, zAngle float64) {
 
 // Creates a new three-dimensional model with zero vertices and reserves memory space for 10 vertices and 10 faces.
 // But you can add more than 10 vertices and faces to the model.
+// The added faces belong to the DefaultGroup until the SetGroups method is called.
 func NewModel() *Model {
 	return &Model{
 		vertices: make([]*Vertex, 0, 10),
 		faces:    make([]*Face, 0, 10),
+		groups:   []string{DefaultGroup},
 	}
 }
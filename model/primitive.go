@@ -0,0 +1,73 @@
+package model
+
+// Describes a point marker in three-dimensional space and the parts of the model it belongs to.
+type Point struct {
+	vertex   *Vertex
+	groups   []string  // The names of the groups the point belongs to.
+	object   string    // The name of the object the point belongs to, empty if not specified.
+	material *Material // The material of the point, nil if not specified.
+}
+
+// Returns the vertex of the point.
+func (p *Point) Vertex() Vertex {
+	return *p.vertex
+}
+
+// Returns the names of the groups the point belongs to.
+func (p *Point) Groups() []string {
+	return p.groups
+}
+
+// Returns the name of the object the point belongs to, empty if the object is not specified.
+func (p *Point) Object() string {
+	return p.object
+}
+
+// Returns the material of the point, nil if the material is not specified.
+func (p *Point) Material() *Material {
+	return p.material
+}
+
+// Describes a polyline in three-dimensional space, passing through all its vertices,
+// and the parts of the model it belongs to.
+type Line struct {
+	vertices []*Vertex
+	groups   []string  // The names of the groups the line belongs to.
+	object   string    // The name of the object the line belongs to, empty if not specified.
+	material *Material // The material of the line, nil if not specified.
+}
+
+// Returns the vertex of the line by index, the index of the first vertex is 0.
+func (l *Line) Vertex(index int) Vertex {
+	return *l.vertices[index]
+}
+
+// Returns the number of the line vertices.
+func (l *Line) VerticesCount() int {
+	return len(l.vertices)
+}
+
+// Returns the names of the groups the line belongs to.
+func (l *Line) Groups() []string {
+	return l.groups
+}
+
+// Returns the name of the object the line belongs to, empty if the object is not specified.
+func (l *Line) Object() string {
+	return l.object
+}
+
+// Returns the material of the line, nil if the material is not specified.
+func (l *Line) Material() *Material {
+	return l.material
+}
+
+// Returns true if one of the groups has the specified name.
+func inGroup(groups []string, name string) bool {
+	for _, group := range groups {
+		if group == name {
+			return true
+		}
+	}
+	return false
+}
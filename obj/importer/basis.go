@@ -0,0 +1,213 @@
+package importer
+
+import (
+	"computer_graphics/obj/parser/types"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A polynomial segment of a free-form curve or surface in one direction.
+type span struct {
+	start, end float64 // The global parameter values of the ends of the segment.
+	first      int     // The index of the first control point that affects the segment.
+	knot       int     // The index of the knot that starts the segment (used only by B-splines).
+}
+
+// Describes the basis functions of a free-form curve or surface in one direction.
+type basis struct {
+	kind       types.FreeFormType // The type of the basis.
+	degree     int                // The degree of the basis functions.
+	parameters []float64          // The global parameter values (knot vector for B-splines).
+	matrix     []float64          // The basis matrix in row-major order (used only by basis matrices).
+	count      int                // The number of control points in the direction.
+	spans      []span             // The polynomial segments in the order of increasing parameter values.
+}
+
+// Returns the binomial coefficient.
+func binomial(n, k int) float64 {
+	var res = 1.0
+	for i := 1; i <= k; i++ {
+		res = res * float64(n-k+i) / float64(i)
+	}
+	return res
+}
+
+// Creates the basis of a curve or surface in one direction.
+// Returns an error if the parameters do not correspond to the type of the basis.
+func newBasis(kind types.FreeFormType, degree int, parameters []float64, matrix *types.BasisMatrix, step int) (*basis, error) {
+	if degree < 1 {
+		return nil, fmt.Errorf("the degree must be greater than zero, received: %d", degree)
+	}
+	if len(parameters) < 2 {
+		return nil, errors.New("at least two global parameter values must be specified")
+	}
+	for i := 1; i < len(parameters); i++ {
+		if parameters[i] < parameters[i-1] {
+			return nil, errors.New("the global parameter values must be in non-decreasing order")
+		}
+		if kind != types.Bspline && parameters[i] == parameters[i-1] {
+			return nil, errors.New("the global parameter values must be in increasing order")
+		}
+	}
+	var (
+		b        = &basis{kind: kind, degree: degree, parameters: parameters}
+		segments = len(parameters) - 1
+		first    = func(i int) int { return 0 }
+	)
+	switch kind {
+	case types.Bezier:
+		b.count = degree*segments + 1
+		first = func(i int) int { return i * degree }
+	case types.Cardinal:
+		if degree != 3 {
+			return nil, fmt.Errorf("the degree of the cardinal spline must be 3, received: %d", degree)
+		}
+		b.count = segments + 3
+		first = func(i int) int { return i }
+	case types.Taylor:
+		b.count = (degree + 1) * segments
+		first = func(i int) int { return i * (degree + 1) }
+	case types.Bmatrix:
+		if matrix == nil {
+			return nil, errors.New("the basis matrix is not specified")
+		}
+		if len(matrix.Matrix) != (degree+1)*(degree+1) {
+			return nil, fmt.Errorf(
+				"the basis matrix must contain %d values, received: %d",
+				(degree+1)*(degree+1),
+				len(matrix.Matrix),
+			)
+		}
+		if step < 1 {
+			return nil, errors.New("the step must be greater than zero")
+		}
+		b.matrix = matrix.Matrix
+		b.count = (segments-1)*step + degree + 1
+		first = func(i int) int { return i * step }
+	case types.Bspline:
+		b.count = len(parameters) - degree - 1
+		if b.count < degree+1 {
+			return nil, fmt.Errorf("the B-spline of degree %d must have at least %d knots", degree, 2*degree+2)
+		}
+		for i := degree; i < b.count; i++ {
+			if parameters[i] < parameters[i+1] {
+				b.spans = append(b.spans, span{start: parameters[i], end: parameters[i+1], first: i - degree, knot: i})
+			}
+		}
+		if len(b.spans) == 0 {
+			return nil, errors.New("the B-spline has no knot span of non-zero length")
+		}
+		return b, nil
+	}
+	b.spans = make([]span, segments)
+	for i := 0; i < segments; i++ {
+		b.spans[i] = span{start: parameters[i], end: parameters[i+1], first: first(i)}
+	}
+	return b, nil
+}
+
+// Calculates the values of the degree+1 basis functions that are not equal to zero on the span at the point u.
+// The values correspond to the control points starting from span.first.
+func (b *basis) values(s span, u float64, res []float64) {
+	if b.kind == types.Bspline {
+		b.bsplineValues(s, u, res)
+		return
+	}
+	var (
+		n = b.degree
+		t = (u - s.start) / (s.end - s.start)
+	)
+	switch b.kind {
+	case types.Bezier:
+		for i := 0; i <= n; i++ {
+			res[i] = binomial(n, i) * math.Pow(t, float64(i)) * math.Pow(1-t, float64(n-i))
+		}
+	case types.Cardinal:
+		var (
+			t2 = t * t
+			t3 = t2 * t
+		)
+		res[0] = (-t + 2*t2 - t3) / 2
+		res[1] = (2 - 5*t2 + 3*t3) / 2
+		res[2] = (t + 4*t2 - 3*t3) / 2
+		res[3] = (-t2 + t3) / 2
+	case types.Taylor:
+		for i := 0; i <= n; i++ {
+			res[i] = math.Pow(t, float64(i))
+		}
+	case types.Bmatrix:
+		// Each row of the matrix contains the coefficients of the polynomial for one control point.
+		for i := 0; i <= n; i++ {
+			res[i] = 0
+			for j := n; j >= 0; j-- {
+				res[i] = res[i]*t + b.matrix[i*(n+1)+j]
+			}
+		}
+	}
+}
+
+// Calculates the values of the B-spline basis functions using the Cox-de Boor recursion formula.
+func (b *basis) bsplineValues(s span, u float64, res []float64) {
+	var (
+		n     = b.degree
+		k     = b.parameters
+		left  = make([]float64, n+1)
+		right = make([]float64, n+1)
+	)
+	res[0] = 1
+	for j := 1; j <= n; j++ {
+		left[j] = u - k[s.knot+1-j]
+		right[j] = k[s.knot+j] - u
+		var saved = 0.0
+		for r := 0; r < j; r++ {
+			var temp = res[r] / (right[r+1] + left[j-r])
+			res[r] = saved + right[r+1]*temp
+			saved = left[j-r] * temp
+		}
+		res[j] = saved
+	}
+}
+
+// A point of the parameter space at which a curve or surface is evaluated.
+type sample struct {
+	span int     // The index of the span containing the point.
+	u    float64 // The global parameter value.
+}
+
+// Creates the samples dividing the part of the basis between the start and end parameter values.
+// segments returns the number of parts into which the span between the two parameter values should be divided.
+// If start is greater than end, the samples are created in the reverse order.
+func (b *basis) samples(start, end float64, segments func(s span, from, to float64) int) []sample {
+	var (
+		reverse = start > end
+		res     []sample
+	)
+	if reverse {
+		start, end = end, start
+	}
+	for i, s := range b.spans {
+		var (
+			from = math.Max(start, s.start)
+			to   = math.Min(end, s.end)
+		)
+		if from >= to {
+			continue
+		}
+		var n = segments(s, from, to)
+		// The first point of the span coincides with the last point of the previous span.
+		var j = 0
+		if len(res) > 0 {
+			j = 1
+		}
+		for ; j <= n; j++ {
+			res = append(res, sample{span: i, u: from + (to-from)*float64(j)/float64(n)})
+		}
+	}
+	if reverse {
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+	}
+	return res
+}
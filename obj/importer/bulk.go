@@ -0,0 +1,313 @@
+package importer
+
+import (
+	"bytes"
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"computer_graphics/obj/parser/types"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// The size of the chunks into which the file is split by the ImportInto method, a chunk is extended to the end of the line.
+// It is a variable so that the tests can split small files.
+var chunkSize = 256 * 1024
+
+// Reads the model from io.Reader into m in the same way as the ImportWithReport method and returns the statistics of the import.
+//
+// The file is split into chunks of whole lines. The lines of the vertices, texture vertices, normals and faces
+// written without comments, trailing spaces and line continuations are read in place from the chunk,
+// without creating the elements of the parser, so reading a large mesh allocates little more than the model itself.
+// If the Workers field is greater than 1, the chunks are read by a pool of goroutines.
+// The model is built by the calling goroutine in the order of the file: the negative indices are resolved
+// and the attributes are assigned in the same way as in the sequential import,
+// the other lines are read by the parser at their place, and the chunks are read ahead while it is done.
+// After the first free-form geometry statement, the rest of the file is read by the parser.
+// The model, the diagnostics and the report are the same as the ones of the ImportWithReport method.
+//
+// The elements are added after the elements of m, so the negative indices can refer to its vertices.
+// Returns an error if the reader fails or the Policy considers one of the conditions of the import fatal.
+func (i *Importer) ImportInto(in io.Reader, m *model.Model) (*ImportReport, error) {
+	var ipt = *i
+	ipt.current = newImportReport()
+	// The parser reads nothing, it applies its settings to all the diagnostics as the parser of the ImportWithReport does.
+	var file = parser.NewParser(bytes.NewReader(nil))
+	file.Output(nil)
+	file.Handle(ipt.deliver)
+	file.SetStrictness(ipt.Strictness)
+	file.SetMaxErrors(ipt.MaxErrors)
+	ipt.source = file
+	var b = &bulkImport{
+		ipt:  &ipt,
+		m:    m,
+		st:   &state{directory: ipt.Directory, materials: make(map[string]*model.Material)},
+		file: file,
+		in:   in,
+	}
+	if ipt.Workers > 1 {
+		b.importConcurrently(ipt.Workers)
+	} else {
+		b.importSequentially()
+	}
+	ipt.tessellate(m, &b.st.freeForms)
+	var err = file.Err()
+	if err == nil {
+		err = b.readErr
+	}
+	if err != nil {
+		return ipt.current, fmt.Errorf("failed to read the model: %w", err)
+	}
+	return ipt.current, ipt.Policy.check(m, ipt.current)
+}
+
+// Splits the file into chunks and imports their records into the model.
+type bulkImport struct {
+	ipt     *Importer
+	m       *model.Model
+	st      *state
+	file    parser.Parser // The parser reporting the diagnostics of the whole file.
+	in      io.Reader     // The reader of the file.
+	eof     bool          // true if the reader has reached the end of the file or failed.
+	readErr error         // The error of the reader.
+	carry   []byte        // The bytes read after the last chunk.
+	chunks  int           // The number of the chunks read.
+	line    int           // The number of the first line of the chunk being imported starting from 0.
+	stopped bool          // true if the import is finished before the end of the chunks.
+}
+
+// Reads the next chunk of the file into c, reusing its memory.
+// The chunk is at least chunkSize bytes long unless it is the end of the file.
+// Returns false if there are no more chunks.
+func (b *bulkImport) readChunk(c *chunk) bool {
+	c.data = append(c.data[:0], b.carry...)
+	c.first = b.chunks == 0
+	b.carry = b.carry[:0]
+	var size = chunkSize
+	for size <= len(c.data) {
+		size *= 2
+	}
+	for ; !b.eof; size *= 2 {
+		if cap(c.data) < size {
+			c.data = append(make([]byte, 0, size), c.data...)
+		}
+		var n, err = io.ReadFull(b.in, c.data[len(c.data):size])
+		c.data = c.data[:len(c.data)+n]
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			b.eof = true
+		} else if err != nil {
+			b.eof, b.readErr = true, err
+		} else if k := chunkEnd(c.data); k > 0 {
+			b.carry = append(b.carry, c.data[k:]...)
+			c.data = c.data[:k]
+			break
+		}
+	}
+	if len(c.data) == 0 {
+		return false
+	}
+	b.chunks++
+	return true
+}
+
+// The chunks whose memory is reused by the following imports.
+var chunksPool = sync.Pool{New: func() interface{} { return new(chunk) }}
+
+// Reads and imports the chunks one by one in the calling goroutine.
+func (b *bulkImport) importSequentially() {
+	var c = chunksPool.Get().(*chunk)
+	defer chunksPool.Put(c)
+	for !b.stopped && b.readChunk(c) {
+		c.read()
+		b.importChunk(c, nil)
+	}
+}
+
+// Reads the chunks by the specified number of goroutines and imports them in the order of the file.
+// Twice as many chunks as the goroutines are read ahead.
+func (b *bulkImport) importConcurrently(workers int) {
+	var (
+		work  = make(chan *chunk, 2*workers)
+		queue = make([]*chunk, 0, 2*workers) // The chunks being read in the order of the file.
+		group sync.WaitGroup
+	)
+	for k := 0; k < workers; k++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for c := range work {
+				c.read()
+				close(c.done)
+			}
+		}()
+	}
+	var chunks = make([]*chunk, cap(queue))
+	for k := range chunks {
+		chunks[k] = chunksPool.Get().(*chunk)
+	}
+	defer func() {
+		close(work)
+		group.Wait()
+		for _, c := range chunks {
+			chunksPool.Put(c)
+		}
+	}()
+	// Sends the chunk to the workers if there is one more chunk in the file.
+	var submit = func(c *chunk) {
+		if b.readChunk(c) {
+			c.done = make(chan struct{})
+			queue = append(queue, c)
+			work <- c
+		}
+	}
+	for _, c := range chunks {
+		submit(c)
+	}
+	for len(queue) > 0 && !b.stopped {
+		var c = queue[0]
+		<-c.done
+		b.importChunk(c, queue[1:])
+		queue = queue[1:]
+		submit(c)
+	}
+}
+
+// Imports the records of the chunk into the model.
+// The chunks following it are read by the parser if the chunk ends with the rest of the file.
+func (b *bulkImport) importChunk(c *chunk, following []*chunk) {
+	for _, r := range c.records {
+		if b.stopped || b.file.Err() != nil {
+			b.stopped = true
+			return
+		}
+		var line = b.line + r.line
+		if r.elementType != parser.UnknownElement && r.elementType != parser.EndOfFile {
+			b.ipt.current.Elements[r.elementType]++
+		}
+		switch r.elementType {
+		case parser.Vertex:
+			var (
+				v      [7]float64
+				count  = copy(v[:], c.values[r.begin:r.end])
+				vertex = types.Vertex{X: v[0], Y: v[1], Z: v[2]}
+			)
+			switch count {
+			case 4:
+				vertex.W = v[3]
+			case 6:
+				vertex.Color = &types.Color{R: v[3], G: v[4], B: v[5]}
+			case 7:
+				vertex.W = v[3]
+				vertex.Color = &types.Color{R: v[4], G: v[5], B: v[6]}
+			}
+			b.ipt.importVertex(&vertex, b.m, &b.st.freeForms)
+		case parser.VertexTexture:
+			var v [3]float64
+			copy(v[:], c.values[r.begin:r.end])
+			b.m.AppendTextureVertex(v[0], v[1], v[2])
+		case parser.VertexNormal:
+			var v = c.values[r.begin:r.end]
+			b.m.AppendNormal(v[0], v[1], v[2])
+		case parser.Face:
+			b.ipt.importCorners(line, c.corners[r.begin:r.end], b.m)
+		case parser.UnknownElement:
+			var p = b.newPartParser(bytes.NewReader(c.data[r.begin:r.end]), line, c.first && r.begin == 0)
+			b.stopped = !b.ipt.importElements(p, b.m, b.st)
+		case parser.EndOfFile:
+			b.importRest(c, r, following)
+			b.stopped = true
+			return
+		}
+	}
+	b.line += c.lines
+}
+
+// Reads the rest of the file starting with the record of the chunk by the parser
+// combining the free-form geometry statements into blocks.
+// The rest consists of the following chunks, the bytes read after them and the unread part of the file.
+func (b *bulkImport) importRest(c *chunk, r record, following []*chunk) {
+	var readers = []io.Reader{bytes.NewReader(c.data[r.begin:])}
+	for _, f := range following {
+		readers = append(readers, bytes.NewReader(f.data))
+	}
+	readers = append(readers, bytes.NewReader(b.carry))
+	if !b.eof {
+		readers = append(readers, b.in)
+	}
+	var p = parser.NewBlockParser(b.newPartParser(io.MultiReader(readers...), b.line+r.line, c.first && r.begin == 0))
+	p.Output(nil)
+	b.ipt.importElements(p, b.m, b.st)
+	if err := p.Err(); err != nil && b.readErr == nil {
+		b.readErr = err
+	}
+}
+
+// Creates a parser of the part of the file starting from the line with the specified number.
+// The scanner skips the byte order mark at the beginning of its reader, which is the beginning of the file
+// only for the first part. The other parts are read after an empty line, so that the mark is reported
+// as an error in the same way as by the parser of the whole file.
+func (b *bulkImport) newPartParser(reader io.Reader, line int, first bool) *partParser {
+	if !first {
+		reader, line = io.MultiReader(bytes.NewReader(newLine), reader), line-1
+	}
+	var p = parser.NewParser(&partReader{Reader: reader, file: b.file})
+	p.Output(nil)
+	p.SetStrictness(b.file.Strictness())
+	p.Handle(func(d parser.Diagnostic) {
+		d.Line += line
+		b.file.Report(d)
+	})
+	return &partParser{Parser: p, offset: line, file: b.file}
+}
+
+// Reads the part of the file until the import is aborted by the parser of the whole file,
+// so that the parser of the part does not read the lines following the aborting diagnostic.
+type partReader struct {
+	io.Reader
+	file parser.Parser // The parser reporting the diagnostics of the whole file.
+}
+
+// Implementation of the Read method in the io.Reader interface.
+func (r *partReader) Read(p []byte) (int, error) {
+	if r.file.Err() != nil {
+		return 0, io.EOF
+	}
+	return r.Reader.Read(p)
+}
+
+// The empty line preceding the parts of the file that do not start it.
+var newLine = []byte{'\n'}
+
+// A parser of a part of the file, whose lines are numbered from the beginning of the file.
+// Its diagnostics are reported by the parser of the whole file, which also decides when the part ends.
+type partParser struct {
+	parser.Parser
+	offset int           // The number of the lines before the part.
+	file   parser.Parser // The parser reporting the diagnostics of the whole file.
+}
+
+// Implementation of the Next method in the parser.Parser interface.
+// The part ends when the import is aborted by the parser of the whole file,
+// including the case when the diagnostic aborting it is reported while the element is being read,
+// so no element is returned after the diagnostic, as the parser of the whole file would do.
+func (p *partParser) Next() (parser.ElementType, interface{}) {
+	if p.file.Err() != nil {
+		return parser.EndOfFile, nil
+	}
+	var elementType, element = p.Parser.Next()
+	if p.file.Err() != nil {
+		return parser.EndOfFile, nil
+	}
+	return elementType, element
+}
+
+// Implementation of the Line method in the parser.Parser interface.
+func (p *partParser) Line() int {
+	return p.Parser.Line() + p.offset
+}
+
+// Implementation of the Report method in the parser.Parser interface.
+// The lines of the diagnostic are numbered from the beginning of the file, as returned by the Line method.
+func (p *partParser) Report(d parser.Diagnostic) {
+	p.file.Report(d)
+}
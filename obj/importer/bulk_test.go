@@ -0,0 +1,158 @@
+package importer
+
+import (
+	"bytes"
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// The file with a large mesh read by the benchmarks.
+const largeMesh = "../../examples/testdata/rabbit.obj"
+
+// Imports the data by the ImportWithReport and ImportInto methods and returns an error if the results differ.
+func compareImports(ipt Importer, data []byte) error {
+	var (
+		expected, actual parser.Collector
+		bulk             = model.NewModel()
+	)
+	ipt.Handler = expected.Handle
+	var m, report, err = ipt.ImportWithReport(bytes.NewReader(data))
+	ipt.Handler = actual.Handle
+	var bulkReport, bulkErr = ipt.ImportInto(bytes.NewReader(data), bulk)
+	switch {
+	case fmt.Sprint(err) != fmt.Sprint(bulkErr):
+		return fmt.Errorf("the error is %v, want %v", bulkErr, err)
+	case !reflect.DeepEqual(expected.Diagnostics, actual.Diagnostics):
+		return fmt.Errorf("the diagnostics are %v, want %v", actual.Diagnostics, expected.Diagnostics)
+	case !reflect.DeepEqual(report, bulkReport):
+		return fmt.Errorf("the report is %+v, want %+v", bulkReport, report)
+	case !reflect.DeepEqual(m, bulk):
+		return fmt.Errorf("the models differ")
+	}
+	return nil
+}
+
+// Checks that the ImportInto method reads the files and the lines that are not read in place
+// in the same way as the ImportWithReport method, splitting the files into chunks of different sizes
+// and reading them by the calling goroutine and by a pool of goroutines.
+func TestImporter_ImportInto(t *testing.T) {
+	var defaultSize = chunkSize
+	defer func() { chunkSize = defaultSize }()
+	for _, chunkSize = range []int{defaultSize, 1024, 16} {
+		for _, workers := range []int{0, 3} {
+			testImportInto(t, workers)
+		}
+	}
+}
+
+// Returns a file with an invalid line and a face with an invalid index after every 100 vertices,
+// so that the import with the maximum number of errors is aborted after the chunks following the error are read ahead.
+func sparseErrors() string {
+	var res strings.Builder
+	for k := 1; k <= 400; k++ {
+		fmt.Fprintf(&res, "v %d 0 0\n", k)
+		switch {
+		case k%100 == 0:
+			res.WriteString("f 1 2 x\nf 1 2 1000\n")
+		case k > 2:
+			fmt.Fprintf(&res, "f %d %d %d\n", k-2, k-1, k)
+		}
+	}
+	return res.String()
+}
+
+// Compares the ImportInto method with the specified number of workers to the ImportWithReport method.
+func testImportInto(t *testing.T, workers int) {
+	var files = []string{"testdata/freeform.obj", "testdata/groups.obj", "../../examples/testdata/fox.obj", largeMesh}
+	for _, file := range files {
+		var data, err = os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = compareImports(Importer{Directory: "../../examples/testdata", Workers: workers}, data); err != nil {
+			t.Errorf("%s, chunk size: %d, workers: %d: %v", file, chunkSize, workers, err)
+		}
+	}
+	var inputs = []string{
+		"\xEF\xBB\xBFv 0 0 0\r\nv 1 0 0\r\nv 0 1 0\r\nf 1 2 3\r\n",
+		"v 0 0 0\rv 1 0 0\rv 0 1 0\rf 1 2 3\rf 1 2 4\r",
+		"v 0 0 0 # origin\nv 1 0 0 \nv 0 1\\\n 0\nf 1 2 \\\n3\n\n# comment\nf 1 2 3 \nf 1 2 3#\nf 1 2 4",
+		"v 0 0 0 1 0.5 0.5 0.5\nv 1 0 0 1 1 1\nv 0 1 0 1 1\nv .5 5. -1e-2\nv +1 x 0\nvt 1\nvt 1 2\nvt 1 2 3 4\nvn 0 0\nvn 0 0 1\n",
+		"v 0 0 0\nv 1 0 0\nv 0 1 0\nvt 0 0\nvn 0 0 1\nf 1/1 2/1 3/1\nf 1//1 2//1 3//1\nf 1/1/1 2/1/1 3/1/1\nf 1 2/1 3\nf 1/ 2/ 3/\nf -1 -2 -3\nf +1 2 3 4 5\n",
+		"v 0 0 0\nv 2 0 0\nv 2 2 0\nv 0 2 1\nf 1 2 3 4\nf 1 2 3 5\ng side\nusemtl wood\ns 1\no box\nl 1 2 3\np 1 5\nf 4 3 2\n",
+		"v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\ncstype bezier\ndeg 1 1\nsurf 0 1 0 1 1 2 3 1\nparm u 0 1\nparm v 0 1\nend\nf 1 2 3\nv 0 0 1\n",
+		"v 0 0 0\rv 1 0 0\rv 0 1 0\rf 1 2 3\rcstype bezier\rdeg 1 1\rsurf 0 1 0 1 1 2 \\\r3 1\rparm u 0 1\rparm v 0 1\rend\rf 1 2 3\r",
+		"v 0 0 0\r\nv 1 0 0\rv 0 1 \\\r0\nf 1 2 3\r\ncurv2 1 2\rend\r\nf 1 2 3\r",
+		"v 0 0 0\nv 1 2 0\nv 2 0 0\ng arc\ncstype bezier\ndeg 2\nctech cparm 2\ncurv 0 1 1 2 3\nparm u 0 1\nend\nl 1 3\nf 1 2 3\n",
+		"v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 7\nf 1 2 8\nf 1 2 9\nunknown\nf 1 2 3\n",
+		"v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\nf 1 2 x\nl 1 2\np 3\n",
+		"v 0 0 0\n\xEF\xBB\xBFv 1 0 0\nv 0 1 0\n\xEF\xBB\xBFf 1 2 3\nf 1 2 3\n",
+		"\xEF\xBB\xBFg side # the first line\nv 0 0 0\nv 1 0 0\nv 0 1 0\n\xEF\xBB\xBFcstype bezier\nf 1 2 3\n",
+		sparseErrors(),
+	}
+	var importers = []Importer{
+		{},
+		{Strictness: parser.Strict},
+		{Strictness: parser.Lenient},
+		{MaxErrors: 1},
+		{MaxErrors: 2},
+		{Triangulation: FirstTriangle},
+	}
+	for _, input := range inputs {
+		for _, ipt := range importers {
+			ipt.Workers = workers
+			if err := compareImports(ipt, []byte(input)); err != nil {
+				t.Errorf("%q, chunk size: %d, %+v: %v", input, chunkSize, ipt, err)
+			}
+		}
+	}
+}
+
+// Measures the import of the large mesh by the Import method.
+func BenchmarkImporter_Import(b *testing.B) {
+	var data, err = os.ReadFile(largeMesh)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	var ipt = Importer{IgnoreWarnings: true}
+	for k := 0; k < b.N; k++ {
+		ipt.Import(bytes.NewReader(data))
+	}
+}
+
+// Measures the import of the large mesh by the ImportInto method in the calling goroutine.
+func BenchmarkImporter_ImportInto(b *testing.B) {
+	var data, err = os.ReadFile(largeMesh)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	var ipt = Importer{IgnoreWarnings: true}
+	for k := 0; k < b.N; k++ {
+		if _, err = ipt.ImportInto(bytes.NewReader(data), model.NewModel()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Measures the import of the large mesh by the ImportInto method with a goroutine for each processor.
+func BenchmarkImporter_ImportInto_workers(b *testing.B) {
+	var data, err = os.ReadFile(largeMesh)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	var ipt = Importer{IgnoreWarnings: true, Workers: runtime.NumCPU()}
+	for k := 0; k < b.N; k++ {
+		if _, err = ipt.ImportInto(bytes.NewReader(data), model.NewModel()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package importer
+
+import (
+	"bytes"
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"strconv"
+)
+
+// The byte order mark that the parser skips at the beginning of the file.
+var bom = []byte("\xEF\xBB\xBF")
+
+// The statements of the free-form geometry that the parser.NewBlockParser combines into blocks.
+// After the first of them, the rest of the file is read by the parser.
+var freeFormStatements = map[string]bool{
+	"cstype": true,
+	"deg":    true,
+	"bmat":   true,
+	"step":   true,
+	"curv":   true,
+	"curv2":  true,
+	"surf":   true,
+	"parm":   true,
+	"trim":   true,
+	"hole":   true,
+	"scrv":   true,
+	"sp":     true,
+	"end":    true,
+	"ctech":  true,
+	"stech":  true,
+}
+
+// Describes a statement of the chunk or the lines of the chunk that are read by the parser.
+type record struct {
+	// The type of the statement read in place: Vertex, VertexTexture, VertexNormal or Face.
+	// UnknownElement for the lines read by the parser, EndOfFile for the rest of the file starting with a free-form statement.
+	elementType parser.ElementType
+	// The number of the line of the statement or the first line read by the parser, starting from 0 at the beginning of the chunk.
+	line int
+	// The range of the numbers of the vertex or the corners of the face, the range of the bytes of the lines read by the parser.
+	begin, end int
+}
+
+// A part of the file consisting of whole lines, which are read without the model.
+// The vertices and the faces are read in place, the other statements are left to the parser.
+// The chunks of the file can be read concurrently, their records are imported in the order of the file.
+type chunk struct {
+	data    []byte         // The text of the chunk.
+	lines   int            // The number of the ends of the lines in the chunk.
+	records []record       // The statements of the chunk in the order of the lines.
+	values  []float64      // The numbers of the vertices of the chunk.
+	corners []model.Corner // The corners of the faces of the chunk.
+	fields  [][]byte       // The fields of the line being read.
+	first   bool           // true if the chunk is the beginning of the file.
+	done    chan struct{}  // Closed when the chunk is read by a worker.
+}
+
+// Returns the length of the part of the data ending with the end of a line that is not continued on the next line,
+// 0 if there is no such line.
+// A '\r' at the end of the data is not a line end yet, because it may be followed by a '\n' that is not read.
+func chunkEnd(data []byte) int {
+	var k = bytes.LastIndexAny(data, "\r\n")
+	if k == len(data)-1 && data[k] == '\r' {
+		k = bytes.LastIndexAny(data[:k], "\r\n")
+	}
+	for k >= 0 {
+		if !bytes.HasSuffix(trimLineEnd(data[:k+1]), []byte{'\\'}) {
+			return k + 1
+		}
+		if data[k] == '\n' && k > 0 && data[k-1] == '\r' {
+			k--
+		}
+		k = bytes.LastIndexAny(data[:k], "\r\n")
+	}
+	return 0
+}
+
+// Returns the length of the first line of the data with its end: "\n", "\r\n" or "\r" as the parser reads them.
+// Returns the length of the data if it does not contain a line end.
+func lineLength(data []byte) int {
+	var k = bytes.IndexAny(data, "\r\n")
+	switch {
+	case k < 0:
+		return len(data)
+	case data[k] == '\r' && k+1 < len(data) && data[k+1] == '\n':
+		return k + 2
+	}
+	return k + 1
+}
+
+// Reads the records of the lines of the chunk.
+// The reading stops at the first free-form statement, the rest of the file must be read by the parser.
+func (c *chunk) read() {
+	c.records, c.values, c.corners = c.records[:0], c.values[:0], c.corners[:0]
+	var (
+		line      = 0
+		continued = false // true if the previous line ends with a line continuation.
+	)
+	for begin, end := 0, 0; begin < len(c.data); begin = end {
+		end = begin + lineLength(c.data[begin:])
+		var text = trimLineEnd(c.data[begin:end])
+		if c.first && begin == 0 {
+			text = bytes.TrimPrefix(text, bom)
+		}
+		var r = record{line: line}
+		switch {
+		case !continued && c.readStatement(text, &r):
+			c.records = append(c.records, r)
+		case !continued && freeFormStatements[string(statementName(text))]:
+			c.appendLine(begin, end, line)
+			c.records[len(c.records)-1].elementType = parser.EndOfFile
+			return
+		case c.pending(begin) || len(bytes.TrimLeft(text, " \t")) > 0:
+			c.appendLine(begin, end, line)
+		}
+		continued = bytes.HasSuffix(text, []byte{'\\'})
+		line += lineEnds(c.data[begin:end])
+	}
+	c.lines = line
+}
+
+// Returns true if the lines read by the parser end at the specified byte.
+func (c *chunk) pending(begin int) bool {
+	var n = len(c.records)
+	return n > 0 && c.records[n-1].elementType == parser.UnknownElement && c.records[n-1].end == begin
+}
+
+// Adds the line to the lines read by the parser, which are joined with the preceding lines read by the parser.
+func (c *chunk) appendLine(begin, end, line int) {
+	if c.pending(begin) {
+		c.records[len(c.records)-1].end = end
+		return
+	}
+	c.records = append(c.records, record{elementType: parser.UnknownElement, line: line, begin: begin, end: end})
+}
+
+// Reads the vertex, the texture vertex, the normal or the face from the text of the line into the record.
+// Returns false if the line contains another statement or is not written in the way read in place.
+func (c *chunk) readStatement(text []byte, r *record) bool {
+	var ok bool
+	if c.fields, ok = splitFields(text, c.fields[:0]); !ok || len(c.fields) < 2 {
+		return false
+	}
+	switch string(c.fields[0]) {
+	case "v":
+		// Five numbers are read by the parser, which reports the missing color component.
+		r.elementType = parser.Vertex
+		return len(c.fields) != 6 && c.readNumbers(c.fields[1:], 3, 7, r)
+	case "vt":
+		r.elementType = parser.VertexTexture
+		return c.readNumbers(c.fields[1:], 1, 3, r)
+	case "vn":
+		r.elementType = parser.VertexNormal
+		return c.readNumbers(c.fields[1:], 3, 3, r)
+	case "f":
+		r.elementType = parser.Face
+		return c.readCorners(c.fields[1:], r)
+	}
+	return false
+}
+
+// Reads the numbers of the vertex into the record, the number of the fields must be in the range [min, max].
+// Returns false if one of the fields is not a number.
+func (c *chunk) readNumbers(fields [][]byte, min, max int, r *record) bool {
+	if len(fields) < min || len(fields) > max {
+		return false
+	}
+	r.begin = len(c.values)
+	for _, field := range fields {
+		var value, ok = parseFloat(field)
+		if !ok {
+			c.values = c.values[:r.begin]
+			return false
+		}
+		c.values = append(c.values, value)
+	}
+	r.end = len(c.values)
+	return true
+}
+
+// Reads the corners of the face in one of the formats v, v/vt, v//vn and v/vt/vn, the same for all the corners.
+// Returns false if one of the fields is not a corner.
+func (c *chunk) readCorners(fields [][]byte, r *record) bool {
+	if len(fields) < 3 {
+		return false
+	}
+	r.begin = len(c.corners)
+	var first int
+	for k, field := range fields {
+		var corner, format, ok = readCorner(field)
+		if !ok || k > 0 && format != first {
+			c.corners = c.corners[:r.begin]
+			return false
+		}
+		first = format
+		c.corners = append(c.corners, corner)
+	}
+	r.end = len(c.corners)
+	return true
+}
+
+// Returns the line without the end of the line.
+func trimLineEnd(data []byte) []byte {
+	data = bytes.TrimSuffix(data, []byte{'\n'})
+	return bytes.TrimSuffix(data, []byte{'\r'})
+}
+
+// Returns the number of the ends of the lines in the data: "\n", "\r\n" and "\r" as the parser reads them.
+func lineEnds(data []byte) int {
+	var count = 0
+	for k, c := range data {
+		if c == '\n' || c == '\r' && (k+1 == len(data) || data[k+1] != '\n') {
+			count++
+		}
+	}
+	return count
+}
+
+// Returns the name of the statement written in the text of the line, empty if the line does not contain a statement.
+func statementName(text []byte) []byte {
+	text = bytes.TrimLeft(text, " \t")
+	if k := bytes.IndexAny(text, " \t#\\"); k >= 0 {
+		return text[:k]
+	}
+	return text
+}
+
+// Splits the text of the line into the fields separated by spaces and tabs, appending them to fields.
+// Returns false if the line is empty or ends with a space or a tab.
+func splitFields(text []byte, fields [][]byte) ([][]byte, bool) {
+	var begin = -1
+	for k, c := range text {
+		switch {
+		case c == ' ' || c == '\t':
+			if begin >= 0 {
+				fields = append(fields, text[begin:k])
+				begin = -1
+			}
+		case begin < 0:
+			begin = k
+		}
+	}
+	if begin < 0 {
+		return fields, false
+	}
+	return append(fields, text[begin:]), true
+}
+
+// Reads the corner of the face in one of the formats v, v/vt, v//vn and v/vt/vn.
+// Returns the corner and the format: the number of the indices, or 0 for the v//vn format.
+func readCorner(field []byte) (model.Corner, int, bool) {
+	var (
+		c      model.Corner
+		parts  [3][]byte
+		count  = 0
+		begin  = 0
+		ok     bool
+		format int
+	)
+	for k := 0; k <= len(field); k++ {
+		if k == len(field) || field[k] == '/' {
+			if count == len(parts) {
+				return c, 0, false
+			}
+			parts[count] = field[begin:k]
+			count, begin = count+1, k+1
+		}
+	}
+	if c.Vertex, ok = parseInt(parts[0]); !ok {
+		return c, 0, false
+	}
+	format = count
+	if count > 1 {
+		if count == 3 && len(parts[1]) == 0 {
+			format = 0
+		} else if c.Texture, ok = parseInt(parts[1]); !ok {
+			return c, 0, false
+		}
+	}
+	if count == 3 {
+		if c.Normal, ok = parseInt(parts[2]); !ok {
+			return c, 0, false
+		}
+	}
+	return c, format, true
+}
+
+// Reads the integer written as the digits with an optional sign.
+// Returns false if the token is not such an integer or is too long.
+func parseInt(token []byte) (int, bool) {
+	var negative = len(token) > 0 && token[0] == '-'
+	if len(token) > 0 && (token[0] == '-' || token[0] == '+') {
+		token = token[1:]
+	}
+	if len(token) == 0 || len(token) > 18 {
+		return 0, false
+	}
+	var value = 0
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		value = value*10 + int(c-'0')
+	}
+	if negative {
+		value = -value
+	}
+	return value, true
+}
+
+// Returns the index of the first byte of the token that is not a digit, starting from the specified index.
+func skipDigits(token []byte, k int) int {
+	for k < len(token) && token[k] >= '0' && token[k] <= '9' {
+		k++
+	}
+	return k
+}
+
+// Reads the number written in the way the scanner reads it: an optional sign, the digits with an optional fraction
+// or only the fraction, and an optional exponent.
+// Returns false if the token is not such a number or is out of range.
+func parseFloat(token []byte) (float64, bool) {
+	var k = 0
+	if k < len(token) && (token[k] == '-' || token[k] == '+') {
+		k++
+	}
+	var (
+		digits   = skipDigits(token, k) - k
+		fraction = 0
+	)
+	k += digits
+	if k < len(token) && token[k] == '.' {
+		fraction = skipDigits(token, k+1) - k - 1
+		k += fraction + 1
+	}
+	if digits == 0 && fraction == 0 {
+		return 0, false
+	}
+	if k < len(token) && (token[k] == 'e' || token[k] == 'E') {
+		k++
+		if k < len(token) && (token[k] == '-' || token[k] == '+') {
+			k++
+		}
+		var exponent = skipDigits(token, k)
+		if exponent == k {
+			return 0, false
+		}
+		k = exponent
+	}
+	if k != len(token) {
+		return 0, false
+	}
+	var value, err = strconv.ParseFloat(string(token), 64)
+	return value, err == nil
+}
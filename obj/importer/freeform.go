@@ -0,0 +1,662 @@
+package importer
+
+import (
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"computer_graphics/obj/parser/types"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	defaultResolution = 4  // The resolution of the constant parametric subdivision if the technique is not specified.
+	maxSegments       = 64 // The maximum number of segments into which one span can be divided.
+)
+
+// A point in three-dimensional space.
+type point struct {
+	x, y, z float64
+}
+
+// Returns the distance between two points.
+func distance(a, b point) float64 {
+	return math.Sqrt((a.x-b.x)*(a.x-b.x) + (a.y-b.y)*(a.y-b.y) + (a.z-b.z)*(a.z-b.z))
+}
+
+// Returns the angle between the vectors ab and bc in degrees.
+func angle(a, b, c point) float64 {
+	var (
+		x1, y1, z1 = b.x - a.x, b.y - a.y, b.z - a.z
+		x2, y2, z2 = c.x - b.x, c.y - b.y, c.z - b.z
+		l          = math.Sqrt((x1*x1 + y1*y1 + z1*z1) * (x2*x2 + y2*y2 + z2*z2))
+	)
+	if l == 0 {
+		return 0
+	}
+	return math.Acos(math.Max(-1, math.Min(1, (x1*x2+y1*y2+z1*z2)/l))) * 180 / math.Pi
+}
+
+// Approximation technique parameters common to curves and surfaces.
+type technique struct {
+	kind        types.ApproximationTechnique // The approximation technique.
+	resolution  float64                      // The resolution for the constant parametric subdivision.
+	maxLength   float64                      // The maximum length of a segment for the constant spatial subdivision.
+	maxDistance float64                      // The maximum distance to the curve for the curvature-dependent subdivision.
+	maxAngle    float64                      // The maximum angle between segments for the curvature-dependent subdivision.
+}
+
+// Returns the number of segments into which the part of the curve between the parameter values should be divided.
+// evaluate returns the point of the curve by the parameter value.
+func (t technique) segments(degree int, from, to float64, evaluate func(u float64) point) int {
+	var n = 1
+	switch t.kind {
+	case types.ConstantParametric, types.ConstantParametricB:
+		n = int(math.Ceil(t.resolution * float64(degree)))
+	case types.ConstantSpatial:
+		if t.maxLength > 0 {
+			// The length of the curve is estimated by a polyline.
+			var (
+				length = 0.0
+				prev   = evaluate(from)
+			)
+			for i := 1; i <= maxSegments; i++ {
+				var next = evaluate(from + (to-from)*float64(i)/maxSegments)
+				length += distance(prev, next)
+				prev = next
+			}
+			n = int(math.Ceil(length / t.maxLength))
+		}
+	case types.CurvatureDependent:
+		// The number of segments is doubled until each segment is close enough to the curve.
+		for n < maxSegments && !t.close(n, from, to, evaluate) {
+			n *= 2
+		}
+	}
+	return int(math.Max(1, math.Min(maxSegments, float64(n))))
+}
+
+// Returns true if the polyline of n segments satisfies the curvature-dependent technique parameters.
+func (t technique) close(n int, from, to float64, evaluate func(u float64) point) bool {
+	for i := 0; i < n; i++ {
+		var (
+			u1  = from + (to-from)*float64(i)/float64(n)
+			u2  = from + (to-from)*float64(i+1)/float64(n)
+			p1  = evaluate(u1)
+			p2  = evaluate(u2)
+			mid = evaluate((u1 + u2) / 2)
+		)
+		if distance(mid, point{(p1.x + p2.x) / 2, (p1.y + p2.y) / 2, (p1.z + p2.z) / 2}) > t.maxDistance {
+			return false
+		}
+		if angle(p1, mid, p2) > t.maxAngle {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the curve approximation technique, the default technique is used if ct is nil.
+func curveTechnique(ct *types.CurveApproximation) technique {
+	if ct == nil {
+		return technique{kind: types.ConstantParametric, resolution: defaultResolution}
+	}
+	var t = technique{kind: ct.Technique}
+	switch ct.Technique {
+	case types.ConstantParametric:
+		t.resolution = ct.Value
+	case types.ConstantSpatial:
+		t.maxLength = ct.Value
+	case types.CurvatureDependent:
+		t.maxDistance, t.maxAngle = ct.Value, ct.MaxAngle
+	}
+	return t
+}
+
+// Returns the surface approximation techniques in the u and v directions, the default technique is used if st is nil.
+func surfaceTechnique(st *types.SurfaceApproximation) (technique, technique) {
+	if st == nil {
+		var t = technique{kind: types.ConstantParametric, resolution: defaultResolution}
+		return t, t
+	}
+	var u, v = technique{kind: st.Technique}, technique{kind: st.Technique}
+	switch st.Technique {
+	case types.ConstantParametric:
+		u.resolution, v.resolution = st.Value, st.Second
+	case types.ConstantParametricB:
+		u.resolution, v.resolution = st.Value, st.Value
+	case types.ConstantSpatial:
+		u.maxLength, v.maxLength = st.Value, st.Value
+	case types.CurvatureDependent:
+		u.maxDistance, u.maxAngle = st.Value, st.Second
+		v.maxDistance, v.maxAngle = st.Value, st.Second
+	}
+	return u, v
+}
+
+// Returns the index of the span containing the parameter value.
+func (b *basis) find(u float64) int {
+	for i, s := range b.spans {
+		if u <= s.end {
+			return i
+		}
+	}
+	return len(b.spans) - 1
+}
+
+// Creates the basis of the curve or surface in the direction.
+// Returns an error if the attributes required to describe the basis are not specified.
+func directionBasis(a *parser.FreeFormAttributes, direction types.DirectionType, parameters []float64) (*basis, error) {
+	if a.Type == nil {
+		return nil, errors.New("the curve or surface type is not specified")
+	}
+	if a.Degree == nil {
+		return nil, errors.New("the degree is not specified")
+	}
+	var (
+		degree = a.Degree.U
+		matrix = a.UMatrix
+		step   = 0
+	)
+	if a.Step != nil {
+		step = a.Step.U
+	}
+	if direction == types.V {
+		degree = a.Degree.V
+		matrix = a.VMatrix
+		if a.Step != nil {
+			step = a.Step.V
+		}
+	}
+	if parameters == nil {
+		return nil, fmt.Errorf("the global parameter values in the %s direction are not specified", directionName(direction))
+	}
+	return newBasis(a.Type.Type, degree, parameters, matrix, step)
+}
+
+// Returns the name of the parameter direction.
+func directionName(direction types.DirectionType) string {
+	if direction == types.V {
+		return "v"
+	}
+	return "u"
+}
+
+// A free-form curve: a space curve or a 2D curve in the parameter space of a surface used for trimming.
+// The points of the 2D curves lie in the plane z = 0.
+type curve struct {
+	basis     *basis    // The basis of the curve.
+	rational  bool      // If true, the weights of the control points are taken into account.
+	points    []point   // The control points.
+	weights   []float64 // The weights of the control points.
+	technique technique // The approximation technique.
+}
+
+// Returns the point of the curve by the parameter value.
+func (c *curve) evaluate(s sample) point {
+	var (
+		sp     = c.basis.spans[s.span]
+		values = make([]float64, c.basis.degree+1)
+		res    point
+		weight float64
+	)
+	c.basis.values(sp, s.u, values)
+	for i, value := range values {
+		var (
+			p = c.points[sp.first+i]
+			w = value
+		)
+		if c.rational {
+			w *= c.weights[sp.first+i]
+		}
+		res.x += w * p.x
+		res.y += w * p.y
+		res.z += w * p.z
+		weight += w
+	}
+	if c.rational && weight != 0 {
+		res.x /= weight
+		res.y /= weight
+		res.z /= weight
+	}
+	return res
+}
+
+// Returns the polyline approximating the part of the curve between the parameter values.
+func (c *curve) polyline(start, end float64) []point {
+	var (
+		samples = c.basis.samples(start, end, func(s span, from, to float64) int {
+			return c.technique.segments(c.basis.degree, from, to, func(u float64) point {
+				return c.evaluate(sample{span: c.basis.find(u), u: u})
+			})
+		})
+		res = make([]point, len(samples))
+	)
+	for i, s := range samples {
+		res[i] = c.evaluate(s)
+	}
+	return res
+}
+
+// A surface waiting for tessellation.
+type surface struct {
+	line           int                    // The line of the end statement of the surface.
+	uBasis, vBasis *basis                 // The bases of the surface in the u and v directions.
+	rational       bool                   // If true, the weights of the control points are taken into account.
+	points         []point                // The control points, the index of the point (i, j) is j*uBasis.count+i.
+	weights        []float64              // The weights of the control points.
+	uTechnique     technique              // The approximation technique in the u direction.
+	vTechnique     technique              // The approximation technique in the v direction.
+	block          *parser.FreeForm       // The description of the surface.
+	trims, holes   [][]types.CurveSegment // The trimming loops with the absolute indices of the curves.
+	attributes     attributes             // The attributes assigned to the triangles of the surface.
+}
+
+// Returns the point of the surface by the parameter values.
+func (s *surface) evaluate(u, v sample) point {
+	var (
+		su      = s.uBasis.spans[u.span]
+		sv      = s.vBasis.spans[v.span]
+		uValues = make([]float64, s.uBasis.degree+1)
+		vValues = make([]float64, s.vBasis.degree+1)
+		res     point
+		weight  float64
+	)
+	s.uBasis.values(su, u.u, uValues)
+	s.vBasis.values(sv, v.u, vValues)
+	for j, vValue := range vValues {
+		for i, uValue := range uValues {
+			var (
+				index = (sv.first+j)*s.uBasis.count + su.first + i
+				p     = s.points[index]
+				w     = uValue * vValue
+			)
+			if s.rational {
+				w *= s.weights[index]
+			}
+			res.x += w * p.x
+			res.y += w * p.y
+			res.z += w * p.z
+			weight += w
+		}
+	}
+	if s.rational && weight != 0 {
+		res.x /= weight
+		res.y /= weight
+		res.z /= weight
+	}
+	return res
+}
+
+// Returns the samples dividing the surface in the direction.
+// The number of segments is determined by the isoparametric curves at the ends and in the middle of the other direction.
+func (s *surface) samples(direction types.DirectionType) []sample {
+	var (
+		b, other   = s.uBasis, s.vBasis
+		start, end = s.block.Surface.StartU, s.block.Surface.EndU
+		otherStart = s.block.Surface.StartV
+		otherEnd   = s.block.Surface.EndV
+		t          = s.uTechnique
+	)
+	if direction == types.V {
+		b, other = s.vBasis, s.uBasis
+		start, end = s.block.Surface.StartV, s.block.Surface.EndV
+		otherStart, otherEnd = s.block.Surface.StartU, s.block.Surface.EndU
+		t = s.vTechnique
+	}
+	return b.samples(start, end, func(sp span, from, to float64) int {
+		var n = 1
+		for _, o := range []float64{otherStart, (otherStart + otherEnd) / 2, otherEnd} {
+			var os = sample{span: other.find(o), u: o}
+			n = int(math.Max(float64(n), float64(t.segments(b.degree, from, to, func(u float64) point {
+				var us = sample{span: b.find(u), u: u}
+				if direction == types.V {
+					return s.evaluate(os, us)
+				}
+				return s.evaluate(us, os)
+			}))))
+		}
+		return n
+	})
+}
+
+// Returns true if the point is inside the polygon, the even-odd rule is used.
+func inside(p point, polygon []point) bool {
+	var res = false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		var a, b = polygon[i], polygon[j]
+		if (a.y > p.y) != (b.y > p.y) && p.x < (b.x-a.x)*(p.y-a.y)/(b.y-a.y)+a.x {
+			res = !res
+		}
+	}
+	return res
+}
+
+// Contains the free-form geometry read during the import.
+// The curves and the surfaces are tessellated after all other elements are read,
+// so that the indices of the vertices in the file coincide with the indices of the vertices in the model.
+type freeForms struct {
+	weights    []float64                // The weights of the geometric vertices.
+	parameters []*types.VertexParameter // The parameter space vertices.
+	curves2D   []*curve                 // The 2D curves, nil if the curve was read with an error.
+	curves     []*spaceCurve            // The space curves waiting for tessellation.
+	surfaces   []*surface               // The surfaces waiting for tessellation.
+}
+
+// Returns the absolute index of the element, the index of the first element is 1.
+// Supports negative indexing relative to count, returns an error if the index is out of range.
+func absoluteIndex(index, count int, name string) (int, error) {
+	if index < 0 {
+		index += count + 1
+	}
+	if index < 1 || index > count {
+		return 0, fmt.Errorf("unresolved %s index: %d", name, index)
+	}
+	return index, nil
+}
+
+// Imports a single 2D curve used for trimming.
+func (i *Importer) importCurve2D(line int, block *parser.FreeForm, f *freeForms) {
+	var c, err = newCurve2D(block, f)
+	if err != nil {
+		i.error(line, parser.Curve2D, err.Error()+", the curve will be skipped")
+	}
+	// The curve is added even in case of an error so as not to shift the indices of the following curves.
+	f.curves2D = append(f.curves2D, c)
+}
+
+// Creates the curve with the control points from the description of the curve or the 2D curve.
+// The control points are not set.
+func newCurve(block *parser.FreeForm, count int) (*curve, error) {
+	var b, err = directionBasis(&block.Attributes, types.U, block.UParameters)
+	if err != nil {
+		return nil, err
+	}
+	if count != b.count {
+		return nil, fmt.Errorf("the curve must have %d control points, received: %d", b.count, count)
+	}
+	return &curve{
+		basis:     b,
+		rational:  block.Attributes.Type.Rational,
+		points:    make([]point, count),
+		weights:   make([]float64, count),
+		technique: curveTechnique(block.Attributes.CurveTechnique),
+	}, nil
+}
+
+// Creates the 2D curve from its description.
+func newCurve2D(block *parser.FreeForm, f *freeForms) (*curve, error) {
+	var c, err = newCurve(block, len(block.Curve2D.Vertices))
+	if err != nil {
+		return nil, err
+	}
+	for j, index := range block.Curve2D.Vertices {
+		if index, err = absoluteIndex(index, len(f.parameters), "parameter space vertex"); err != nil {
+			return nil, err
+		}
+		var vp = f.parameters[index-1]
+		c.points[j] = point{vp.U, vp.V, 0}
+		c.weights[j] = vp.W
+		if vp.W == 0 {
+			c.weights[j] = 1
+		}
+	}
+	return c, nil
+}
+
+// A space curve waiting for tessellation.
+type spaceCurve struct {
+	*curve                // The curve to divide into segments.
+	line       int        // The line of the end statement of the curve.
+	start, end float64    // The parameter values of the ends of the curve.
+	attributes attributes // The attributes assigned to the line of the curve.
+}
+
+// Imports a single space curve, it will be tessellated at the end of the import.
+func (i *Importer) importCurve(line int, block *parser.FreeForm, m *model.Model, st *state) {
+	var c, err = newSpaceCurve(line, block, m, &st.freeForms, st.attributes)
+	if err != nil {
+		i.error(line, parser.Curve, err.Error()+", the curve will be skipped")
+		return
+	}
+	st.curves = append(st.curves, c)
+}
+
+// Creates the space curve from its description.
+func newSpaceCurve(line int, block *parser.FreeForm, m *model.Model, f *freeForms, a attributes) (*spaceCurve, error) {
+	var c, err = newCurve(block, len(block.Curve.Vertices))
+	if err != nil {
+		return nil, err
+	}
+	for j, index := range block.Curve.Vertices {
+		if index, err = absoluteIndex(index, m.VerticesCount(), "vertex"); err != nil {
+			return nil, err
+		}
+		var vertex, _ = m.GetVertex(index)
+		c.points[j] = point{vertex.X, vertex.Y, vertex.Z}
+		c.weights[j] = f.weights[index-1]
+	}
+	return &spaceCurve{curve: c, line: line, start: block.Curve.Start, end: block.Curve.End, attributes: a}, nil
+}
+
+// Divides the space curve into segments and adds them to the model as a line.
+func tessellateCurve(c *spaceCurve, m *model.Model) error {
+	var points = c.polyline(c.start, c.end)
+	if len(points) < 2 {
+		return fmt.Errorf("the curve has no points between the parameter values %g and %g", c.start, c.end)
+	}
+	var vertices = make([]int, len(points))
+	for j, p := range points {
+		m.AppendVertex(p.x, p.y, p.z)
+		vertices[j] = m.VerticesCount()
+	}
+	return m.AppendLine(vertices...)
+}
+
+// Imports a single surface, it will be tessellated at the end of the import.
+func (i *Importer) importSurface(line int, block *parser.FreeForm, m *model.Model, st *state) {
+	var s, err = newSurface(line, block, m, &st.freeForms, st.attributes)
+	if err != nil {
+		i.error(line, parser.Surface, err.Error()+", the surface will be skipped")
+		return
+	}
+	st.surfaces = append(st.surfaces, s)
+}
+
+// Creates the surface from its description.
+func newSurface(line int, block *parser.FreeForm, m *model.Model, f *freeForms, a attributes) (*surface, error) {
+	var (
+		s = &surface{
+			line:       line,
+			block:      block,
+			rational:   block.Attributes.Type != nil && block.Attributes.Type.Rational,
+			attributes: a,
+		}
+		err error
+	)
+	if s.uBasis, err = directionBasis(&block.Attributes, types.U, block.UParameters); err != nil {
+		return nil, err
+	}
+	if s.vBasis, err = directionBasis(&block.Attributes, types.V, block.VParameters); err != nil {
+		return nil, err
+	}
+	var count = s.uBasis.count * s.vBasis.count
+	if len(block.Surface.Vertices) != count {
+		return nil, fmt.Errorf("the surface must have %d control points, received: %d", count, len(block.Surface.Vertices))
+	}
+	s.points = make([]point, count)
+	s.weights = make([]float64, count)
+	for j, v := range block.Surface.Vertices {
+		var index int
+		if index, err = absoluteIndex(v.Index, m.VerticesCount(), "vertex"); err != nil {
+			return nil, err
+		}
+		var vertex, _ = m.GetVertex(index)
+		s.points[j] = point{vertex.X, vertex.Y, vertex.Z}
+		s.weights[j] = f.weights[index-1]
+	}
+	s.uTechnique, s.vTechnique = surfaceTechnique(block.Attributes.SurfaceTechnique)
+	// The relative indices of the curves refer to the curves read before the surface.
+	var loops = func(segments []types.CurveSegment) ([]types.CurveSegment, error) {
+		var res = make([]types.CurveSegment, len(segments))
+		for j, segment := range segments {
+			res[j] = segment
+			if segment.Curve < 0 {
+				if res[j].Curve, err = absoluteIndex(segment.Curve, len(f.curves2D), "curve"); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return res, nil
+	}
+	for _, trim := range block.Trims {
+		var loop []types.CurveSegment
+		if loop, err = loops(trim.Segments); err != nil {
+			return nil, err
+		}
+		s.trims = append(s.trims, loop)
+	}
+	for _, hole := range block.Holes {
+		var loop []types.CurveSegment
+		if loop, err = loops(hole.Segments); err != nil {
+			return nil, err
+		}
+		s.holes = append(s.holes, loop)
+	}
+	return s, nil
+}
+
+// Returns the polygon in the parameter space of the surface described by the trimming loop.
+func (f *freeForms) polygon(loop []types.CurveSegment) ([]point, error) {
+	var res []point
+	for _, segment := range loop {
+		var index, err = absoluteIndex(segment.Curve, len(f.curves2D), "curve")
+		if err != nil {
+			return nil, err
+		}
+		var c = f.curves2D[index-1]
+		if c == nil {
+			return nil, fmt.Errorf("the curve %d was read with an error", index)
+		}
+		res = append(res, c.polyline(segment.Start, segment.End)...)
+	}
+	return res, nil
+}
+
+// Divides the surface into triangles and adds them to the model.
+// Only the triangles whose centers are inside the trimming loops and outside the holes are added.
+func (f *freeForms) tessellate(s *surface, m *model.Model) error {
+	var trims, holes [][]point
+	for _, loop := range s.trims {
+		var polygon, err = f.polygon(loop)
+		if err != nil {
+			return err
+		}
+		trims = append(trims, polygon)
+	}
+	for _, loop := range s.holes {
+		var polygon, err = f.polygon(loop)
+		if err != nil {
+			return err
+		}
+		holes = append(holes, polygon)
+	}
+	var (
+		us      = s.samples(types.U)
+		vs      = s.samples(types.V)
+		indices = make([]int, len(us)*len(vs))
+	)
+	// Returns true if the point of the parameter space belongs to the trimmed surface.
+	var visible = func(u, v float64) bool {
+		var (
+			p  = point{u, v, 0}
+			in = len(trims) == 0
+		)
+		for _, polygon := range trims {
+			if inside(p, polygon) {
+				in = !in
+			}
+		}
+		for _, polygon := range holes {
+			if inside(p, polygon) {
+				return false
+			}
+		}
+		return in
+	}
+	// Returns the index of the model vertex at the grid node, the vertex is added to the model at the first call.
+	var vertex = func(a, b int) int {
+		var index = &indices[b*len(us)+a]
+		if *index == 0 {
+			var p = s.evaluate(us[a], vs[b])
+			m.AppendVertex(p.x, p.y, p.z)
+			*index = m.VerticesCount()
+		}
+		return *index
+	}
+	// Adds the triangle with the vertices at the grid nodes if it is visible.
+	var triangle = func(a1, b1, a2, b2, a3, b3 int) {
+		var (
+			u = (us[a1].u + us[a2].u + us[a3].u) / 3
+			v = (vs[b1].u + vs[b2].u + vs[b3].u) / 3
+		)
+		if visible(u, v) {
+			_ = m.AppendFace(vertex(a1, b1), vertex(a2, b2), vertex(a3, b3))
+		}
+	}
+	for b := 0; b+1 < len(vs); b++ {
+		for a := 0; a+1 < len(us); a++ {
+			triangle(a, b, a+1, b, a+1, b+1)
+			triangle(a, b, a+1, b+1, a, b+1)
+		}
+	}
+	return nil
+}
+
+// Imports the free-form geometry element.
+// Returns false if the element type does not describe the free-form geometry.
+func (i *Importer) importFreeForm(
+	line int,
+	elementType parser.ElementType,
+	element interface{},
+	m *model.Model,
+	st *state,
+) bool {
+	switch elementType {
+	case parser.VertexParameter:
+		st.parameters = append(st.parameters, element.(*types.VertexParameter))
+	case parser.Curve2D:
+		i.importCurve2D(line, element.(*parser.FreeForm), &st.freeForms)
+	case parser.Surface:
+		i.importSurface(line, element.(*parser.FreeForm), m, st)
+	case parser.Curve:
+		i.importCurve(line, element.(*parser.FreeForm), m, st)
+	case parser.Connect:
+		i.info(elementType, "connectivity between free-form surfaces is not used")
+		i.unsupported(elementType)
+	default:
+		return false
+	}
+	return true
+}
+
+// Tessellates all the curves and surfaces read during the import.
+func (i *Importer) tessellate(m *model.Model, f *freeForms) {
+	for _, c := range f.curves {
+		// The line of the curve belongs to the parts of the model in effect when the curve was read.
+		c.attributes.apply(m)
+		if err := tessellateCurve(c, m); err != nil {
+			i.error(c.line, parser.Curve, err.Error()+", the curve will be skipped")
+		}
+	}
+	for _, s := range f.surfaces {
+		if len(s.block.SpecialCurves) > 0 || len(s.block.SpecialPoints) > 0 {
+			i.info(parser.Surface, "special curves and points of free-form surfaces are not used")
+		}
+		// The triangles of the surface belong to the parts of the model in effect when the surface was read.
+		s.attributes.apply(m)
+		if err := f.tessellate(s, m); err != nil {
+			i.error(s.line, parser.Surface, err.Error()+", the surface will be skipped")
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"computer_graphics/obj/parser"
+	"computer_graphics/obj/parser/types"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+// A B-spline surface whose knots in the v direction are all equal has no span to evaluate, it is skipped.
+func ExampleImporter_Import_emptyKnotSpans() {
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(strings.NewReader(
+			"v 0 0 0\nv 1 0 0\nv 0 1 0\nv 1 1 0\ncstype bspline\ndeg 1 1\nstech cspace 0.1\n" +
+				"surf 0 1 0 1 1 2 3 4\nparm u 0 0 1 1\nparm v 0 0 0 0\nend\n",
+		))
+	)
+	fmt.Println("vertices:", m.VerticesCount(), "faces:", m.FacesCount())
+	// Output:
+	// [ERROR] line: 11, message: the B-spline has no knot span of non-zero length, the surface will be skipped
+	// vertices: 4 faces: 0
+}
+
+// Evaluates a surface of each type: the points of the tessellated surfaces follow from the basis functions.
+func ExampleImporter_Import_bases() {
+	var (
+		rows = "v 0 0 0\nv 1 0 2\nv 2 0 0\nv 0 1 0\nv 1 1 2\nv 2 1 0\n"
+		surf = "surf 0 1 0 1 1 2 3 4 5 6\nparm u 0 1\nparm v 0 1\nend\n"
+	)
+	var cardinal strings.Builder
+	for j := 0; j < 4; j++ {
+		for i, z := range []int{0, 1, 1, 0} {
+			fmt.Fprintf(&cardinal, "v %d %d %d\n", i-1, j-1, z)
+		}
+	}
+	cardinal.WriteString("cstype cardinal\ndeg 3 3\nstech cparma 0.6 0.3\nsurf 0 1 0 1")
+	for k := 1; k <= 16; k++ {
+		fmt.Fprintf(&cardinal, " %d", k)
+	}
+	cardinal.WriteString("\nparm u 0 1\nparm v 0 1\nend\n")
+	var inputs = []struct{ name, text string }{
+		{"bezier", rows + "cstype bezier\ndeg 2 1\nstech cparma 1 1\n" + surf},
+		// The basis matrices of the Bezier basis give the same surface.
+		{"bmatrix", rows + "cstype bmatrix\ndeg 2 1\nbmat u 1 -2 1 0 2 -2 0 0 1\nbmat v 1 -1 0 1\nstep 1 1\nstech cparma 1 1\n" + surf},
+		// The control points of the Taylor basis are the coefficients of the polynomial: x = 2u, y = v, z = u^2.
+		{"taylor", "v 0 0 0\nv 2 0 0\nv 0 0 1\nv 0 1 0\nv 0 0 0\nv 0 0 0\ncstype taylor\ndeg 2 1\nstech cparma 1 1\n" + surf},
+		// The cardinal spline passes through the inner control points.
+		{"cardinal", cardinal.String()},
+	}
+	for _, input := range inputs {
+		var (
+			ipt = Importer{Output: os.Stdout}
+			m   = ipt.Import(strings.NewReader(input.text))
+		)
+		fmt.Printf("%s:", input.name)
+		for k := m.VerticesCount() - 5; k <= m.VerticesCount(); k++ {
+			var v, _ = m.GetVertex(k)
+			fmt.Printf(" (%g %g %g)", v.X, v.Y, v.Z)
+		}
+		fmt.Println()
+	}
+	// Output:
+	// bezier: (0 0 0) (1 0 1) (1 1 1) (0 1 0) (2 0 0) (2 1 0)
+	// bmatrix: (0 0 0) (1 0 1) (1 1 1) (0 1 0) (2 0 0) (2 1 0)
+	// taylor: (0 0 0) (1 0 0.25) (1 1 0.25) (0 1 0) (2 0 1) (2 1 1)
+	// cardinal: (0 0 1) (0.5 0 1.125) (0.5 1 1.125) (0 1 1) (1 0 1) (1 1 1)
+}
+
+// Divides a Bezier curve and a rational Bezier curve describing a quarter of the unit circle into lines
+// according to their approximation techniques, the curves are assigned the group in effect.
+func ExampleImporter_Import_curves() {
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(strings.NewReader(
+			"v 0 0 0\nv 1 2 0\nv 2 0 0\nv 1 0 0\nv 1 1 0 0.7071067811865476\nv 0 1 0\ng arcs\n" +
+				"cstype bezier\ndeg 2\nctech cparm 2\ncurv 0 1 1 2 3\nparm u 0 1\nend\n" +
+				"cstype rat bezier\nctech cspace 0.2\ncurv 0 1 4 5 6\nparm u 0 1\nend\ncurv 0.5 0.5 1 2 3\nparm u 0 1\nend\n",
+		))
+	)
+	fmt.Println("vertices:", m.VerticesCount(), "lines:", m.LinesCount())
+	var l = m.GetLine(0)
+	fmt.Print("bezier: ", l.Groups())
+	for k := 0; k < l.VerticesCount(); k++ {
+		fmt.Printf(" (%g %g %g)", l.Vertex(k).X, l.Vertex(k).Y, l.Vertex(k).Z)
+	}
+	fmt.Println()
+	l = m.GetLine(1)
+	var radius = 0.0
+	for k := 0; k < l.VerticesCount(); k++ {
+		var v = l.Vertex(k)
+		radius = math.Max(radius, math.Abs(math.Hypot(v.X, v.Y)-1))
+	}
+	fmt.Println("arc:", l.VerticesCount(), "vertices, on the circle:", radius < 1e-12)
+	// Output:
+	// [ERROR] line: 21, message: the curve has no points between the parameter values 0.5 and 0.5, the curve will be skipped
+	// vertices: 20 lines: 2
+	// bezier: [arcs] (0 0 0) (0.5 0.75 0) (1 1 0) (1.5 0.75 0) (2 0 0)
+	// arc: 9 vertices, on the circle: true
+}
+
+// Checks the ends and the middle of the polyline of a quadratic B-spline curve with the clamped knots,
+// which coincides with the Bezier curve.
+func TestCurve_polyline(t *testing.T) {
+	var (
+		block = &parser.FreeForm{
+			Attributes: parser.FreeFormAttributes{
+				Type:   &types.CurveSurfaceType{Type: types.Bspline},
+				Degree: &types.Degree{U: 2},
+			},
+			Curve2D:     &types.Curve2D{Vertices: []int{1, 2, 3}},
+			UParameters: []float64{0, 0, 0, 1, 1, 1},
+		}
+		f = &freeForms{parameters: []*types.VertexParameter{{U: 0, V: 0}, {U: 1, V: 2}, {U: 2, V: 0}}}
+	)
+	var c, err = newCurve2D(block, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var polyline = c.polyline(0, 1)
+	if len(polyline) != 9 {
+		t.Fatalf("Incorrect number of the points, got: %d, want: 9", len(polyline))
+	}
+	for _, test := range []struct {
+		name     string
+		got, exp point
+	}{
+		{"start", polyline[0], point{0, 0, 0}},
+		{"middle", polyline[4], point{1, 1, 0}},
+		{"end", polyline[8], point{2, 0, 0}},
+		{"reversed start", c.polyline(1, 0)[0], point{2, 0, 0}},
+	} {
+		if distance(test.got, test.exp) > 1e-12 {
+			t.Errorf("Incorrect %s point, got: %v, want: %v", test.name, test.got, test.exp)
+		}
+	}
+}
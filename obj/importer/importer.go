@@ -6,68 +6,194 @@ import (
 	"computer_graphics/obj/parser/types"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 )
 
 // Allows you to import a model from a .obj file.
-// Display information about problems that occur during importing.
-// You can disable the output by using the IgnoreInfos, IgnoreWarnings and IgnoreErrors fields.
-// You can also specify io.Writer to output this information to.
+// Reports the problems that occur during importing as diagnostics.
+// You can disable the reporting by using the IgnoreInfos, IgnoreWarnings and IgnoreErrors fields.
+// You can also specify io.Writer to output the text representation of the diagnostics to
+// and a parser.DiagnosticHandler to receive them.
 type Importer struct {
-	Output         io.Writer // Recipient of error and warning messages.
-	IgnoreInfos    bool      // If true, no info messages will be output to the Output.
-	IgnoreWarnings bool      // If true, no warning messages will be output to the Output.
-	IgnoreErrors   bool      // If true, no error messages will be output to the Output.
+	Output         io.Writer                // Recipient of error and warning messages.
+	Handler        parser.DiagnosticHandler // Recipient of the diagnostics in addition to the Output, nil if not set.
+	IgnoreInfos    bool                     // If true, no info messages will be reported.
+	IgnoreWarnings bool                     // If true, no warning messages will be reported.
+	IgnoreErrors   bool                     // If true, no error messages will be reported.
+	// The directory relative to which the material libraries are searched, the current directory if empty.
+	Directory string
+	// Decides which conditions are fatal for the ImportWithReport and ImportFileWithReport methods.
+	Policy Policy
+	// The way the lines that do not match the specification are handled, see the parser.Parser.SetStrictness method.
+	// In the parser.Strict mode, the problems found by the importer abort the import as well.
+	Strictness parser.Strictness
+	// The number of errors after which the import is aborted, 0 if it is not limited.
+	// The errors found by the importer are counted as well.
+	MaxErrors int
+	// The way the faces with more than three vertices are divided into triangles.
+	Triangulation Triangulation
+	// The number of goroutines reading the chunks of the file in the ImportInto method,
+	// if it is less than 2, the chunks are read by the calling goroutine.
+	Workers int
+
+	current *ImportReport // The report of the import in progress.
+	source  parser.Parser // The parser of the import in progress.
+}
+
+// Contains the attributes assigned to the faces being imported.
+type attributes struct {
+	groups         []string        // The groups of the faces.
+	object         string          // The object of the faces.
+	smoothingGroup int             // The smoothing group of the faces.
+	material       *model.Material // The material of the faces.
+}
+
+// Assigns the attributes to all the faces added to the model after the call.
+func (a *attributes) apply(m *model.Model) {
+	m.SetGroups(a.groups...)
+	m.SetObject(a.object)
+	m.SetSmoothingGroup(a.smoothingGroup)
+	m.SetMaterial(a.material)
+}
+
+// Contains the data accumulated during a single import.
+type state struct {
+	attributes                            // The attributes assigned to the following faces.
+	directory  string                     // The directory relative to which the material libraries are searched.
+	materials  map[string]*model.Material // The materials of the read material libraries by their names.
+	freeForms                             // The free-form geometry waiting for tessellation.
+	corners    []model.Corner             // The corners of the face being imported, reused by all the faces.
 }
 
 // Reads the full model.Model from io.Reader.
+// Free-form surfaces are divided into triangles according to their approximation techniques and trimming loops,
+// free-form curves are divided into lines according to their approximation techniques,
+// the vertices of the triangles and the lines are added after the vertices read from the file.
 // Handles errors according to the settings in the fields.
 func (i *Importer) Import(in io.Reader) *model.Model {
+	var m, _, _ = i.ImportWithReport(in)
+	return m
+}
+
+// Reads the full model.Model from io.Reader in the same way as the Import method
+// and returns the statistics of the import.
+// Returns an error if the reader fails or the Policy considers one of the conditions of the import fatal.
+// The model read and the report are returned even if the error is not nil.
+func (i *Importer) ImportWithReport(in io.Reader) (*model.Model, *ImportReport, error) {
+	var ipt = *i
+	ipt.current = newImportReport()
 	// Setting up the parser.
-	var p = parser.NewParser(in)
-	p.Output(i.Output)
-	p.IgnoreErrors(i.IgnoreErrors)
-	p.IgnoreWarnings(i.IgnoreWarnings)
+	// All the diagnostics are reported by the parser to apply its settings and filtered by the importer.
+	var p = parser.NewBlockParser(parser.NewParser(in))
+	p.Output(nil)
+	p.Handle(ipt.deliver)
+	p.SetStrictness(ipt.Strictness)
+	p.SetMaxErrors(ipt.MaxErrors)
+	ipt.source = p
 	// Reading the model.
-	var m = model.NewModel()
-	i.importVertices(p, m)
-	i.importFaces(p, m)
-	return m
+	var (
+		m  = model.NewModel()
+		st = &state{directory: ipt.Directory, materials: make(map[string]*model.Material)}
+	)
+	ipt.importElements(p, m, st)
+	ipt.tessellate(m, &st.freeForms)
+	if err := p.Err(); err != nil {
+		return m, ipt.current, fmt.Errorf("failed to read the model: %w", err)
+	}
+	return m, ipt.current, ipt.Policy.check(m, ipt.current)
 }
 
-// Outputs a message in Output in the format:
-// [INFO] {msg}
-func (i *Importer) info(msg string) {
-	if i.Output != nil && !i.IgnoreInfos {
-		fmt.Fprintln(i.Output, "[INFO]", msg)
+// Reads the full model.Model from the file with the specified name.
+// The material libraries are searched relative to the directory of the file.
+// Returns an error if the file cannot be opened.
+func (i *Importer) ImportFile(name string) (*model.Model, error) {
+	var file, err = os.Open(name)
+	if err != nil {
+		return nil, err
 	}
+	defer file.Close()
+	var ipt = *i
+	ipt.Directory = filepath.Dir(name)
+	return ipt.Import(file), nil
 }
 
-// Outputs a message in Output in the format:
-// [WARNING] line: {line}, message: {msg}
-func (i *Importer) warning(line int, msg string) {
-	if i.Output != nil && !i.IgnoreWarnings {
-		fmt.Fprintf(i.Output, "[WARNING] line: %d, message: %s\n", line, msg)
+// Reads the full model.Model from the file with the specified name in the same way as the ImportFile method
+// and returns the statistics of the import.
+// Returns an error if the file cannot be opened, see the ImportWithReport method for the other errors.
+func (i *Importer) ImportFileWithReport(name string) (*model.Model, *ImportReport, error) {
+	var file, err = os.Open(name)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer file.Close()
+	var ipt = *i
+	ipt.Directory = filepath.Dir(name)
+	return ipt.ImportWithReport(file)
 }
 
-// Outputs a message in Output in the format:
-// [ERROR] line: {line}, message: {msg}
-func (i *Importer) error(line int, msg string) {
-	if i.Output != nil && !i.IgnoreErrors {
-		fmt.Fprintf(i.Output, "[ERROR] line: %d, message: %s\n", line, msg)
+// Adds the diagnostic to the report and delivers it to the Output and the Handler if it is not ignored.
+func (i *Importer) deliver(d parser.Diagnostic) {
+	i.current.add(d)
+	if d.Severity == parser.Info && i.IgnoreInfos ||
+		d.Severity == parser.Warning && i.IgnoreWarnings ||
+		d.Severity == parser.Error && i.IgnoreErrors {
+		return
+	}
+	if i.Output != nil {
+		parser.WriteDiagnostic(i.Output, d)
+	}
+	if i.Handler != nil {
+		i.Handler(d)
 	}
 }
 
+// Reports the diagnostic by the parser of the import.
+func (i *Importer) report(d parser.Diagnostic) {
+	i.source.Report(d)
+}
+
+// Reports an info diagnostic about the element.
+func (i *Importer) info(elementType parser.ElementType, msg string) {
+	i.report(parser.Diagnostic{Severity: parser.Info, ElementType: elementType, Message: msg})
+}
+
+// Reports a warning diagnostic about the element on the line.
+// The line is numbered from 0, as returned by the parser.Parser.
+func (i *Importer) warning(line int, elementType parser.ElementType, msg string) {
+	i.report(parser.Diagnostic{Severity: parser.Warning, Line: line + 1, ElementType: elementType, Message: msg})
+}
+
+// Reports an error diagnostic about the element on the line.
+// The line is numbered from 0, as returned by the parser.Parser.
+func (i *Importer) error(line int, elementType parser.ElementType, msg string) {
+	i.report(parser.Diagnostic{Severity: parser.Error, Line: line + 1, ElementType: elementType, Message: msg})
+}
+
+// Counts the statement that is not supported by the importer in the report.
+func (i *Importer) unsupported(elementType parser.ElementType) {
+	i.current.Unsupported[elementType]++
+}
+
 // Imports a single vertex of the model.
-func (i *Importer) importVertex(line int, v *types.Vertex, m *model.Model) {
-	if v.W != 0 {
-		i.warning(line, "vertex weights are not supported")
+func (i *Importer) importVertex(v *types.Vertex, m *model.Model, f *freeForms) {
+	// The weight is used only by rational curves and surfaces, it is equal to 1 if not specified.
+	if v.W == 0 {
+		f.weights = append(f.weights, 1)
+	} else {
+		f.weights = append(f.weights, v.W)
+	}
+	if v.Color == nil {
+		m.AppendVertex(v.X, v.Y, v.Z)
+	} else {
+		m.AppendColoredVertex(v.X, v.Y, v.Z, model.Color{R: v.Color.R, G: v.Color.G, B: v.Color.B})
 	}
-	m.AppendVertex(v.X, v.Y, v.Z)
 }
 
-// Imports all vertices of the model.
-func (i *Importer) importVertices(p parser.Parser, m *model.Model) {
+// Imports all elements of the model.
+// The vertices can follow the faces, the negative indices refer to the vertices read before the element.
+// Returns false if the import is stopped because of an element that cannot be imported.
+func (i *Importer) importElements(p parser.Parser, m *model.Model, st *state) bool {
 	var (
 		elementType parser.ElementType
 		element     interface{}
@@ -76,55 +202,194 @@ func (i *Importer) importVertices(p parser.Parser, m *model.Model) {
 	for {
 		elementType, element = p.Next()
 		line = p.Line()
+		if elementType != parser.EndOfFile {
+			i.current.Elements[elementType]++
+		}
 		switch elementType {
 		case parser.Vertex:
-			i.importVertex(line, element.(*types.Vertex), m)
-		case parser.Face, parser.EndOfFile:
-			return
+			i.importVertex(element.(*types.Vertex), m, &st.freeForms)
+		case parser.VertexTexture:
+			var vt = element.(*types.VertexTexture)
+			m.AppendTextureVertex(vt.U, vt.V, vt.W)
+		case parser.VertexNormal:
+			var vn = element.(*types.VertexNormal)
+			m.AppendNormal(vn.I, vn.J, vn.K)
+		case parser.Face:
+			i.importFace(line, element.(*types.Face), m, st)
+		case parser.Point:
+			i.importPoint(line, element.(*types.Point), m)
+		case parser.Line:
+			i.importLine(line, element.(*types.Line), m)
+		case parser.EndOfFile:
+			return true
 		default:
-			i.error(line, fmt.Sprintf("An impossible element was read: %s", elementType))
+			switch {
+			case elementType > parser.EndOfFile:
+				// The statements registered by the parser.Register function are read, but the importer does not know them.
+				i.info(elementType, "the registered statements are not imported")
+				i.unsupported(elementType)
+			case !i.importAttribute(line, elementType, element, m, st) && !i.importFreeForm(line, elementType, element, m, st):
+				i.error(line, elementType, fmt.Sprintf("An impossible element was read: %s", elementType))
+				return false
+			}
+		}
+	}
+}
+
+// Imports the statement that changes the attributes of the following faces.
+// Returns false if the element type does not describe such a statement.
+func (i *Importer) importAttribute(
+	line int,
+	elementType parser.ElementType,
+	element interface{},
+	m *model.Model,
+	st *state,
+) bool {
+	switch elementType {
+	case parser.Group:
+		st.groups = element.(*types.Group).Names
+	case parser.Object:
+		st.object = element.(*types.Object).Name
+		if st.object == model.NoObject {
+			st.object = ""
+		}
+	case parser.SmoothingGroup:
+		st.smoothingGroup = element.(*types.SmoothingGroup).Number
+	case parser.MergingGroup:
+		// Merging groups affect only the display of adjacent free-form surfaces.
+	case parser.MaterialLibrary:
+		for _, file := range element.(*types.MaterialLibrary).Files {
+			i.importMaterialLibrary(line, file, st)
+		}
+	case parser.UseMaterial:
+		var name = element.(*types.UseMaterial).Name
+		if material, ok := st.materials[name]; ok {
+			st.material = material
+		} else if name == model.NoMaterial {
+			st.material = nil
+		} else {
+			i.warning(line, elementType, fmt.Sprintf("the material %s is not defined, the faces will be imported without a material", name))
+			st.material = nil
+		}
+	case parser.MapLibrary, parser.UseMapping:
+		i.info(elementType, "texture map libraries are not used")
+		i.unsupported(elementType)
+	case parser.Call:
+		i.warning(line, elementType, fmt.Sprintf("the call statement is not supported, the file %s will not be read", element.(*types.Call).File))
+		i.unsupported(elementType)
+	default:
+		return false
+	}
+	st.attributes.apply(m)
+	return true
+}
+
+// Adds the triangle to the model, reporting the problems with each kind of the indices of its corners.
+// The messages that were already reported for the face are not repeated, the triangles of a polygon share them.
+// The reported map is nil for a triangular face, which adds a single triangle.
+func (i *Importer) appendTriangle(line int, m *model.Model, c1, c2, c3 model.Corner, reported map[string]bool) {
+	var err = m.AppendFaceCorners(c1, c2, c3)
+	if err == nil {
+		return
+	}
+	var faceErr = err.(*model.FaceError)
+	var report = func(err error, severity parser.Severity, consequence string) {
+		if err == nil || reported[err.Error()] {
 			return
 		}
+		if reported != nil {
+			reported[err.Error()] = true
+		}
+		i.report(parser.Diagnostic{
+			Severity:    severity,
+			Line:        line + 1,
+			ElementType: parser.Face,
+			Message:     err.Error() + consequence,
+		})
 	}
+	report(faceErr.Vertex, parser.Error, "")
+	report(faceErr.Texture, parser.Warning, ", the face will be imported without texture vertices")
+	report(faceErr.Normal, parser.Warning, ", the face will be imported without normals")
 }
 
 // Imports a single face of the model.
-func (i *Importer) importFace(line int, f *types.Face, m *model.Model) {
-	if len(f.Vertices) > 3 {
-		i.warning(line, "only triangular faces are supported, the first three vertices will be used as a triangle")
+func (i *Importer) importFace(line int, f *types.Face, m *model.Model, st *state) {
+	st.corners = st.corners[:0]
+	for _, v := range f.Vertices {
+		st.corners = append(st.corners, model.Corner{Vertex: v.Index, Texture: v.Texture, Normal: v.Normal})
 	}
-	if f.Vertices[0].Texture != 0 {
-		i.warning(line, "vertex textures are not supported")
+	i.importCorners(line, st.corners, m)
+}
+
+// Imports a single face of the model with the specified corners.
+// The faces with more than three vertices are divided into triangles in the way specified by the Triangulation field.
+func (i *Importer) importCorners(line int, corners []model.Corner, m *model.Model) {
+	if len(corners) == 3 || i.Triangulation == FirstTriangle {
+		if len(corners) > 3 {
+			i.warning(line, parser.Face, "only triangular faces are supported, the first three vertices will be used as a triangle")
+		}
+		i.appendTriangle(line, m, corners[0], corners[1], corners[2], nil)
+		return
 	}
-	if f.Vertices[0].Normal != 0 {
-		i.warning(line, "vertex normals are not supported")
+	i.current.Polygons++
+	var polygon = make([]point, len(corners))
+	for j, c := range corners {
+		var vertex, err = m.GetVertex(c.Vertex)
+		if err != nil {
+			i.error(line, parser.Face, err.Error())
+			return
+		}
+		polygon[j] = point{vertex.X, vertex.Y, vertex.Z}
 	}
-	var err = m.AppendFace(f.Vertices[0].Index, f.Vertices[1].Index, f.Vertices[2].Index)
-	if err != nil {
-		i.error(line, err.Error())
+	var triangles, status = triangulate(polygon, i.Triangulation)
+	switch status {
+	case polygonNonPlanar:
+		i.current.NonPlanar++
+		i.warning(line, parser.Face, "the vertices of the polygon do not lie in the same plane, its projection will be triangulated")
+	case polygonDegenerate:
+		i.current.Degenerate++
+		i.error(line, parser.Face, "the polygon has no area, the face will be skipped")
+		return
+	case polygonSelfIntersect:
+		i.current.Degenerate++
+		i.warning(line, parser.Face, "the edges of the polygon intersect, it will be triangulated by a fan")
+	}
+	var reported = make(map[string]bool)
+	for _, t := range triangles {
+		i.appendTriangle(line, m, corners[t[0]], corners[t[1]], corners[t[2]], reported)
 	}
 }
 
-// Imports all faces of the model.
-func (i *Importer) importFaces(p parser.Parser, m *model.Model) {
+// Imports all points of the point statement.
+func (i *Importer) importPoint(line int, p *types.Point, m *model.Model) {
+	for _, v := range p.Vertices {
+		if err := m.AppendPoint(v); err != nil {
+			i.error(line, parser.Point, err.Error())
+		}
+	}
+}
+
+// Imports a single line of the model.
+// The texture vertices must be specified either for all the vertices of the line or for none of them,
+// otherwise the line is skipped.
+func (i *Importer) importLine(line int, l *types.Line, m *model.Model) {
 	var (
-		elementType parser.ElementType
-		element     interface{}
-		line        int
+		vertices = make([]int, len(l.Vertices))
+		textured = l.Vertices[0].Texture != 0
 	)
-	for {
-		elementType, element = p.Next()
-		line = p.Line()
-		switch elementType {
-		case parser.Face:
-			i.importFace(line, element.(*types.Face), m)
-		case parser.Vertex:
-			i.error(line, "incorrect order of elements (vertices must be defined before faces), the vertex will be skipped")
-		case parser.EndOfFile:
-			return
-		default:
-			i.error(line, fmt.Sprintf("An impossible element was read: %s", elementType))
+	for j, v := range l.Vertices {
+		if (v.Texture != 0) != textured {
+			i.error(line, parser.Line, fmt.Sprintf(
+				"the texture vertex of the vertex number %d does not match the first vertex, the line will be skipped", j+1,
+			))
 			return
 		}
+		vertices[j] = v.Index
+	}
+	if textured {
+		i.warning(line, parser.Line, "vertex textures are not supported")
+	}
+	if err := m.AppendLine(vertices...); err != nil {
+		i.error(line, parser.Line, err.Error())
 	}
 }
@@ -0,0 +1,336 @@
+package importer
+
+import (
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"computer_graphics/obj/parser/types"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// Tessellates a Bezier patch with a hole and a trimmed B-spline surface, the points of the triangles lie on the surfaces.
+func ExampleImporter_Import_freeForm() {
+	var file, err = os.Open("testdata/freeform.obj")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer file.Close()
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(file)
+	)
+	fmt.Println("vertices:", m.VerticesCount())
+	fmt.Println("faces:", m.FacesCount())
+	// The vertices of the triangles follow the 20 vertices of the file, the Bezier patch is tessellated first.
+	var highest, plane = model.Vertex{}, 0
+	for k := 21; k <= m.VerticesCount(); k++ {
+		var v, _ = m.GetVertex(k)
+		if v.Z > highest.Z && v.Z < 2 {
+			highest = v
+		}
+		if v.Z == 2 {
+			plane++
+		}
+	}
+	fmt.Printf("the highest point of the patch: %.4g %.4g %.4g\n", highest.X, highest.Y, highest.Z)
+	fmt.Println("the points of the B-spline surface:", plane)
+	// Output:
+	// vertices: 81
+	// faces: 66
+	// the highest point of the patch: 1.5 1 0.5
+	// the points of the B-spline surface: 13
+}
+
+// Assigns the groups, the objects and the smoothing groups to the faces that follow them.
+func ExampleImporter_Import_groups() {
+	var file, err = os.Open("testdata/groups.obj")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer file.Close()
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(file)
+	)
+	fmt.Println("groups:", m.Groups())
+	fmt.Println("objects:", m.Objects())
+	for _, name := range m.Groups() {
+		var group = m.Group(name)
+		fmt.Printf("%s: faces: %d, vertices: %d\n", name, group.FacesCount(), group.VerticesCount())
+	}
+	for k := 0; k < m.FacesCount(); k++ {
+		var f = m.GetFace(k)
+		fmt.Println(f.Object(), f.Groups(), f.SmoothingGroup())
+	}
+	// Output:
+	// groups: [default bottom side]
+	// objects: [box]
+	// default: faces: 1, vertices: 3
+	// bottom: faces: 4, vertices: 6
+	// side: faces: 2, vertices: 4
+	//  [default] 0
+	// box [bottom] 1
+	// box [bottom] 1
+	// box [side bottom] 0
+	// box [side bottom] 0
+}
+
+// Collects the problems of the parser and the importer as diagnostics with their lines and element types.
+func ExampleImporter_Import_diagnostics() {
+	var (
+		collector parser.Collector
+		ipt       = Importer{Handler: collector.Handle}
+		m         = ipt.Import(strings.NewReader(
+			"v 0 0 0\nv 1 0 0\nv 0 1 0\nv 1 1 0\nvt 0 0\nvt 1 1\nvn 0 0 1\n" +
+				"f 1/1 2/1 4/2 3/2\nf 1 2 5\nf 1/3/1 2/1/1 3/2/1\nf 1//1 2//-2 3//1\nusemtl wood\n",
+		))
+	)
+	fmt.Println("faces:", m.FacesCount())
+	for k := 0; k < m.FacesCount(); k++ {
+		var (
+			f                    = m.GetFace(k)
+			texture, hasTextures = f.Texture(3)
+			normal, hasNormals   = f.VertexNormal(1)
+		)
+		fmt.Println("texture:", hasTextures, texture, "normal:", hasNormals, normal)
+	}
+	for _, d := range collector.Diagnostics {
+		fmt.Printf("%s, line: %d, element: %s, message: %s\n", d.Severity, d.Line, d.ElementType, d.Message)
+	}
+	// Output:
+	// faces: 4
+	// texture: true {1 1 0} normal: false {0 0 0}
+	// texture: true {1 1 0} normal: false {0 0 0}
+	// texture: false {0 0 0} normal: true {0 0 1}
+	// texture: false {0 0 0} normal: false {0 0 0}
+	// ERROR, line: 9, element: face, message: unresolved vertex index: 5
+	// WARNING, line: 10, element: face, message: unresolved texture vertex index: 3, the face will be imported without texture vertices
+	// WARNING, line: 11, element: face, message: unresolved normal index: -2, the face will be imported without normals
+	// WARNING, line: 12, element: use material, message: the material wood is not defined, the faces will be imported without a material
+}
+
+// Reports the problems of the material library as diagnostics with the name of its file
+// and aborts the import on them in the parser.Strict mode.
+func ExampleImporter_ImportWithReport_materialLibrary() {
+	const input = "mtllib materials.mtl\nv 0 0 0\nv 1 0 0\nv 0 1 0\nusemtl Shiny_Plastic\nf 1 2 3\n"
+	var (
+		collector parser.Collector
+		ipt       = Importer{Handler: collector.Handle, IgnoreInfos: true, Directory: "../mtl/testdata"}
+	)
+	var m, report, err = ipt.ImportWithReport(strings.NewReader(input))
+	fmt.Println("faces:", m.FacesCount(), "material:", m.GetFace(0).Material().Name)
+	fmt.Println("warnings:", report.Warnings, "errors:", report.Errors, "skipped:", report.Skipped, "error:", err)
+	for _, d := range collector.Diagnostics[:2] {
+		fmt.Println(d)
+	}
+	ipt = Importer{Strictness: parser.Strict, Directory: "../mtl/testdata"}
+	m, report, err = ipt.ImportWithReport(strings.NewReader(input))
+	fmt.Println("faces:", m.FacesCount(), "errors:", report.Errors)
+	fmt.Println("error:", err)
+	// Output:
+	// faces: 1 material: Shiny_Plastic
+	// warnings: 2 errors: 5 skipped: map[material library:5] error: <nil>
+	// [ERROR] file: ../mtl/testdata/materials.mtl, line: 2, message: the Kd statement does not follow any material, the line will be skipped
+	// [WARNING] file: ../mtl/testdata/materials.mtl, line: 14, message: spectral colors are not supported, the statement will be skipped
+	// faces: 0 errors: 1
+	// error: failed to read the model: the parsing is aborted at line 2 of ../mtl/testdata/materials.mtl, the strict mode does not allow errors and warnings (errors: 1, warnings: 0): the Kd statement does not follow any material, the line will be skipped
+}
+
+// Imports the points and the lines with the groups in effect, reporting the texture vertices of the lines.
+func ExampleImporter_Import_primitives() {
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(strings.NewReader(
+			"v 0 0 0\nv 1 0 0\nv 0 1 0\nv 0 0 1\nvt 0 0\nf 1 2 3\ng guides\nl 1 4 2\nl 3/1 4/1\nl 1 5\ng markers\np 1 2 -1\n",
+		))
+	)
+	fmt.Println("faces:", m.FacesCount(), "lines:", m.LinesCount(), "points:", m.PointsCount())
+	for k := 0; k < m.LinesCount(); k++ {
+		var l = m.GetLine(k)
+		fmt.Println("line:", l.VerticesCount(), l.Vertex(0), l.Groups())
+	}
+	for k := 0; k < m.PointsCount(); k++ {
+		var p = m.GetPoint(k)
+		fmt.Println("point:", p.Vertex(), p.Groups())
+	}
+	var guides = m.Group("guides")
+	fmt.Println("guides:", guides.FacesCount(), guides.LinesCount(), guides.PointsCount(), guides.VerticesCount())
+	// Output:
+	// [WARNING] line: 9, message: vertex textures are not supported
+	// [ERROR] line: 10, message: unresolved vertex index: 5
+	// faces: 1 lines: 2 points: 3
+	// line: 3 {0 0 0 <nil>} [guides]
+	// line: 2 {0 1 0 <nil>} [guides]
+	// point: {0 0 0 <nil>} [markers]
+	// point: {1 0 0 <nil>} [markers]
+	// point: {0 0 1 <nil>} [markers]
+	// guides: 0 2 0 4
+}
+
+// Resolves the negative indices of the faces relative to the vertices read before them.
+func ExampleImporter_Import_interleaved() {
+	var (
+		ipt = Importer{Output: os.Stdout}
+		m   = ipt.Import(strings.NewReader(
+			"o first\nv 0 0 0\nv 1 0 0\nv 0 1 0\nf -3 -2 -1\n" +
+				"o second\nv 0 0 1\nv 1 0 1\nv 0 1 1\nf -3 -2 -1\nf 1 2 6\nf -1 -2 -7\n",
+		))
+	)
+	fmt.Println("vertices:", m.VerticesCount(), "faces:", m.FacesCount())
+	for k := 0; k < m.FacesCount(); k++ {
+		var f = m.GetFace(k)
+		fmt.Println(f.Object(), f.Vertex1(), f.Vertex2(), f.Vertex3())
+	}
+	// Output:
+	// [ERROR] line: 12, message: unresolved vertex index: -7
+	// vertices: 6 faces: 3
+	// first {0 0 0 <nil>} {1 0 0 <nil>} {0 1 0 <nil>}
+	// second {0 0 1 <nil>} {1 0 1 <nil>} {0 1 1 <nil>}
+	// second {0 0 0 <nil>} {1 0 0 <nil>} {0 1 1 <nil>}
+}
+
+// Divides the polygons into triangles in each Triangulation mode and reports the non-planar and degenerate ones.
+func ExampleImporter_Import_triangulation() {
+	// A concave L-shaped hexagon with the area 3.
+	const hexagon = "v 0 0 0\nv 2 0 0\nv 2 1 0\nv 1 1 0\nv 1 2 0\nv 0 2 0\nf 3 4 5 6 1 2\n"
+	for _, t := range []Triangulation{Auto, Fan, FirstTriangle} {
+		var (
+			ipt  = Importer{Triangulation: t, IgnoreWarnings: true}
+			m    = ipt.Import(strings.NewReader(hexagon))
+			area float64
+		)
+		for k := 0; k < m.FacesCount(); k++ {
+			var _, _, z = m.GetFace(k).Normal()
+			area += math.Abs(z) / 2
+		}
+		fmt.Println("faces:", m.FacesCount(), "area:", area)
+	}
+	// A non-planar quadrangle, a quadrangle without area and a self-intersecting quadrangle.
+	var (
+		ipt            = Importer{Output: os.Stdout}
+		m, report, err = ipt.ImportWithReport(strings.NewReader(
+			"v 0 0 0\nv 2 0 0\nv 2 2 0\nv 0 2 1\nv 3 0 0\nv 0 2 0\nv 1 3 0\nf 1 2 3 4\nf 1 2 5 2\nf 1 2 6 7\n",
+		))
+	)
+	fmt.Println("faces:", m.FacesCount(), "error:", err)
+	fmt.Println("polygons:", report.Polygons, "non-planar:", report.NonPlanar, "degenerate:", report.Degenerate)
+	// Output:
+	// faces: 4 area: 3
+	// faces: 4 area: 4
+	// faces: 1 area: 0.5
+	// [WARNING] line: 8, message: the vertices of the polygon do not lie in the same plane, its projection will be triangulated
+	// [ERROR] line: 9, message: the polygon has no area, the face will be skipped
+	// [WARNING] line: 10, message: the edges of the polygon intersect, it will be triangulated by a fan
+	// faces: 4 error: <nil>
+	// polygons: 3 non-planar: 1 degenerate: 2
+}
+
+// Returns the statistics of the import and an error when the Policy considers a condition fatal or the reader fails.
+func ExampleImporter_ImportWithReport() {
+	var (
+		ipt    = Importer{Policy: Policy{FailOnUnsupported: true}}
+		inputs = []io.Reader{
+			strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nv 1 x 0\nbevel on\ncall part.obj\nf 1 2 3\nf 1 2 4\n"),
+			strings.NewReader("GIF89a\x01\x00\x01\x00\n"),
+			io.MultiReader(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n"), iotest.ErrReader(errors.New("unexpected EOF"))),
+		}
+	)
+	for _, in := range inputs {
+		var m, report, err = ipt.ImportWithReport(in)
+		fmt.Println("faces:", m.FacesCount())
+		fmt.Println("elements:", report.Elements)
+		fmt.Println("skipped:", report.Skipped)
+		fmt.Println("unsupported:", report.Unsupported)
+		fmt.Println("error:", err)
+	}
+	// Output:
+	// faces: 1
+	// elements: map[vertex:3 face:2 call command:1]
+	// skipped: map[vertex:1 face:1]
+	// unsupported: map[bevel interpolation:1 call command:1]
+	// error: unsupported statements were read during the import: 2
+	// faces: 0
+	// elements: map[]
+	// skipped: map[unknown element:1]
+	// unsupported: map[]
+	// error: the model does not contain any faces, lines or points
+	// faces: 1
+	// elements: map[vertex:3 face:1]
+	// skipped: map[]
+	// unsupported: map[]
+	// error: failed to read the model: unexpected EOF
+}
+
+// Checks that the lines whose vertices have texture vertices are imported only if all the vertices have them.
+// The parser does not return such lines, so the elements are passed to the importer directly.
+func TestImporter_importLine(t *testing.T) {
+	var (
+		collector parser.Collector
+		source    = parser.NewParser(strings.NewReader(""))
+		ipt       = Importer{current: newImportReport(), source: source}
+		m         = model.NewModel()
+	)
+	source.Output(nil)
+	source.Handle(collector.Handle)
+	m.AppendVertex(0, 0, 0)
+	m.AppendVertex(1, 0, 0)
+	m.AppendVertex(0, 1, 0)
+	for _, vertices := range [][][2]int{{{1, 1}, {2, 0}, {3, 0}}, {{1, 0}, {2, 2}}, {{1, 1}, {2, 1}}, {{1, 0}, {3, 0}}} {
+		var l = types.NewLine()
+		for _, v := range vertices {
+			l.Vertices = append(l.Vertices, struct {
+				Index   int `name:"index"`
+				Texture int `name:"texture" optional:"true"`
+			}{v[0], v[1]})
+		}
+		ipt.importLine(0, l, m)
+	}
+	if m.LinesCount() != 2 {
+		t.Errorf("Invalid number of the lines, got: %d, want: 2", m.LinesCount())
+	}
+	var messages []string
+	for _, d := range collector.Diagnostics {
+		messages = append(messages, d.Message)
+	}
+	var want = []string{
+		"the texture vertex of the vertex number 2 does not match the first vertex, the line will be skipped",
+		"the texture vertex of the vertex number 2 does not match the first vertex, the line will be skipped",
+		"vertex textures are not supported",
+	}
+	if strings.Join(messages, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Invalid diagnostics, got: %q, want: %q", messages, want)
+	}
+}
+
+// Checks that the statements registered by the parser.Register function are counted as unsupported
+// and the rest of the file is imported by both import methods.
+func TestImporter_Import_registered(t *testing.T) {
+	var vc, err = parser.Register("vc", &struct {
+		R float64 `name:"red component"`
+		G float64 `name:"green component" optional:"true"`
+		B float64 `name:"blue component" optional:"true"`
+	}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const input = "v 0 0 0\nvc 1\nv 1 0 0\nv 0 1 0\nf 1 2 3"
+	var ipt = Importer{}
+	var m, report, importErr = ipt.ImportWithReport(strings.NewReader(input))
+	if importErr != nil || m.VerticesCount() != 3 || m.FacesCount() != 1 || report.Unsupported[vc] != 1 {
+		t.Errorf(
+			"Invalid import, error: %v, vertices: %d, faces: %d, unsupported: %d",
+			importErr, m.VerticesCount(), m.FacesCount(), report.Unsupported[vc],
+		)
+	}
+	if err = compareImports(ipt, []byte(input)); err != nil {
+		t.Error(err)
+	}
+}
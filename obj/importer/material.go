@@ -0,0 +1,59 @@
+package importer
+
+import (
+	"computer_graphics/model"
+	"computer_graphics/obj/mtl"
+	"computer_graphics/obj/parser"
+	"fmt"
+	"path/filepath"
+)
+
+// Converts the color of the material library to the color of the model.
+func convertColor(c mtl.Color) model.Color {
+	return model.Color{R: c.R, G: c.G, B: c.B}
+}
+
+// Converts the material of the material library to the material of the model.
+// The paths to the texture files are resolved relative to the directory of the library.
+func convertMaterial(m *mtl.Material, directory string) *model.Material {
+	var res = &model.Material{
+		Name:             m.Name,
+		Ambient:          convertColor(m.Ambient),
+		Diffuse:          convertColor(m.Diffuse),
+		Specular:         convertColor(m.Specular),
+		Emissive:         convertColor(m.Emissive),
+		SpecularExponent: m.SpecularExponent,
+		Dissolve:         m.Dissolve,
+		Illumination:     m.Illumination,
+	}
+	if m.DiffuseMap != nil {
+		res.DiffuseMap = resolvePath(directory, m.DiffuseMap.File)
+	}
+	return res
+}
+
+// Returns the path to the file relative to the directory, absolute paths are returned unchanged.
+func resolvePath(directory, file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(directory, file)
+}
+
+// Reads the materials from the material library file.
+// Materials with the same names as previously read materials replace them.
+func (i *Importer) importMaterialLibrary(line int, file string, st *state) {
+	var (
+		path   = resolvePath(st.directory, file)
+		reader = mtl.Reader{Handler: i.report}
+	)
+	i.info(parser.MaterialLibrary, fmt.Sprintf("reading the material library %s", path))
+	var materials, err = reader.ReadFile(path)
+	if err != nil {
+		i.error(line, parser.MaterialLibrary, fmt.Sprintf("failed to read the material library: %s", err))
+		return
+	}
+	for _, m := range materials {
+		st.materials[m.Name] = convertMaterial(m, filepath.Dir(path))
+	}
+}
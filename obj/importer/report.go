@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"computer_graphics/model"
+	"computer_graphics/obj/parser"
+	"errors"
+	"fmt"
+)
+
+// Contains the statistics of a single import.
+type ImportReport struct {
+	Elements    map[parser.ElementType]int // The number of elements read from the file by their types.
+	Skipped     map[parser.ElementType]int // The number of lines and elements skipped because of errors by their types.
+	Unsupported map[parser.ElementType]int // The number of statements that are not supported by their types.
+	Warnings    int                        // The number of warnings, including the ignored ones.
+	Errors      int                        // The number of errors, including the ignored ones.
+	Polygons    int                        // The number of faces with more than three vertices.
+	NonPlanar   int                        // The number of polygons whose vertices do not lie in the same plane.
+	Degenerate  int                        // The number of polygons without area or with intersecting edges.
+}
+
+// Creates a new empty report.
+func newImportReport() *ImportReport {
+	return &ImportReport{
+		Elements:    make(map[parser.ElementType]int),
+		Skipped:     make(map[parser.ElementType]int),
+		Unsupported: make(map[parser.ElementType]int),
+	}
+}
+
+// Updates the statistics by the diagnostic.
+// The warnings referring to a token are reported by the parser about the unsupported statements,
+// all the errors mean that the line or the element was skipped.
+func (r *ImportReport) add(d parser.Diagnostic) {
+	switch d.Severity {
+	case parser.Warning:
+		r.Warnings++
+		if d.Column > 0 {
+			r.Unsupported[d.ElementType]++
+		}
+	case parser.Error:
+		r.Errors++
+		r.Skipped[d.ElementType]++
+	}
+}
+
+// Returns the total number of the values in the map.
+func total(counts map[parser.ElementType]int) int {
+	var res = 0
+	for _, count := range counts {
+		res += count
+	}
+	return res
+}
+
+// The errors returned by the import when the Policy considers the condition fatal.
+var (
+	ErrEmptyModel  = errors.New("the model does not contain any faces, lines or points")
+	ErrErrors      = errors.New("errors were reported during the import")
+	ErrWarnings    = errors.New("warnings were reported during the import")
+	ErrUnsupported = errors.New("unsupported statements were read during the import")
+)
+
+// Decides which conditions of the import are fatal.
+// The zero value considers only a model without faces, lines and points as a failure.
+type Policy struct {
+	AllowEmpty        bool // If true, a model without faces, lines and points is not a failure.
+	FailOnErrors      bool // If true, any error is a failure.
+	FailOnWarnings    bool // If true, any warning is a failure.
+	FailOnUnsupported bool // If true, any unsupported statement is a failure.
+}
+
+// Returns the error describing the first fatal condition of the import, or nil.
+func (p Policy) check(m *model.Model, r *ImportReport) error {
+	switch {
+	case p.FailOnErrors && r.Errors > 0:
+		return fmt.Errorf("%w: %d", ErrErrors, r.Errors)
+	case p.FailOnWarnings && r.Warnings > 0:
+		return fmt.Errorf("%w: %d", ErrWarnings, r.Warnings)
+	case p.FailOnUnsupported && total(r.Unsupported) > 0:
+		return fmt.Errorf("%w: %d", ErrUnsupported, total(r.Unsupported))
+	case !p.AllowEmpty && m.FacesCount() == 0 && m.LinesCount() == 0 && m.PointsCount() == 0:
+		return ErrEmptyModel
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package importer
+
+import "math"
+
+// The way the faces with more than three vertices are divided into triangles.
+type Triangulation uint8
+
+const (
+	// Convex polygons are divided by a fan of triangles from the first vertex,
+	// concave polygons are divided by ear clipping.
+	Auto Triangulation = iota
+	// All polygons are divided by a fan of triangles from the first vertex, which is correct only for convex polygons.
+	Fan
+	// Only the first three vertices of the polygon are used as a triangle, the other vertices are dropped.
+	FirstTriangle
+)
+
+const (
+	// The maximum distance from a vertex of the polygon to its plane relative to the size of the polygon,
+	// at which the polygon is still considered planar.
+	planarityTolerance = 1e-3
+	// The minimum area of the polygon relative to the square of its size,
+	// at which the polygon is not considered degenerate.
+	degeneracyTolerance = 1e-9
+)
+
+// The problems of a polygon found during the triangulation.
+type polygonStatus uint8
+
+const (
+	polygonCorrect       polygonStatus = iota // The polygon is planar and simple.
+	polygonNonPlanar                          // The vertices of the polygon do not lie in the same plane.
+	polygonDegenerate                         // The polygon has no area, it cannot be divided into triangles.
+	polygonSelfIntersect                      // The edges of the polygon intersect, it is divided by a fan.
+)
+
+// Returns the cross product of the vectors ab and ac projected on the plane, the sign specifies the turn direction.
+func cross2D(a, b, c point) float64 {
+	return (b.x-a.x)*(c.y-a.y) - (b.y-a.y)*(c.x-a.x)
+}
+
+// Returns the normal of the polygon calculated by the Newell's method.
+// The length of the normal is equal to the doubled area of the planar polygon.
+func newellNormal(polygon []point) point {
+	var n point
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		var a, b = polygon[j], polygon[i]
+		n.x += (a.y - b.y) * (a.z + b.z)
+		n.y += (a.z - b.z) * (a.x + b.x)
+		n.z += (a.x - b.x) * (a.y + b.y)
+	}
+	return n
+}
+
+// Returns the size of the polygon, the diagonal of its bounding box.
+func polygonSize(polygon []point) float64 {
+	var min, max = polygon[0], polygon[0]
+	for _, p := range polygon[1:] {
+		min = point{math.Min(min.x, p.x), math.Min(min.y, p.y), math.Min(min.z, p.z)}
+		max = point{math.Max(max.x, p.x), math.Max(max.y, p.y), math.Max(max.z, p.z)}
+	}
+	return distance(min, max)
+}
+
+// Projects the polygon on the coordinate plane in which its projection has the largest area.
+// The orientation of the projection matches the orientation of the polygon relative to its normal,
+// so the projected polygon is always counterclockwise.
+func project(polygon []point, normal point) []point {
+	var (
+		res        = make([]point, len(polygon))
+		ax, ay, az = math.Abs(normal.x), math.Abs(normal.y), math.Abs(normal.z)
+	)
+	for i, p := range polygon {
+		switch {
+		case ax >= ay && ax >= az:
+			res[i] = point{x: p.y, y: p.z}
+			if normal.x < 0 {
+				res[i].x = -res[i].x
+			}
+		case ay >= az:
+			res[i] = point{x: p.z, y: p.x}
+			if normal.y < 0 {
+				res[i].x = -res[i].x
+			}
+		default:
+			res[i] = point{x: p.x, y: p.y}
+			if normal.z < 0 {
+				res[i].x = -res[i].x
+			}
+		}
+	}
+	return res
+}
+
+// Returns true if the counterclockwise projected polygon is convex.
+func convex(polygon []point) bool {
+	var n = len(polygon)
+	for i := 0; i < n; i++ {
+		if cross2D(polygon[i], polygon[(i+1)%n], polygon[(i+2)%n]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if the edges ab and cd of the projected polygon cross each other at inner points.
+func crossing(a, b, c, d point) bool {
+	var (
+		d1, d2 = cross2D(a, b, c), cross2D(a, b, d)
+		d3, d4 = cross2D(c, d, a), cross2D(c, d, b)
+	)
+	return (d1 > 0 && d2 < 0 || d1 < 0 && d2 > 0) && (d3 > 0 && d4 < 0 || d3 < 0 && d4 > 0)
+}
+
+// Returns true if the non-adjacent edges of the projected polygon do not cross each other.
+func simple(polygon []point) bool {
+	var n = len(polygon)
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if crossing(polygon[i], polygon[i+1], polygon[j], polygon[(j+1)%n]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Divides the counterclockwise projected polygon into triangles by a fan from the first vertex.
+// The triangles without area are skipped.
+func fan(polygon []point) [][3]int {
+	var res = make([][3]int, 0, len(polygon)-2)
+	for i := 1; i < len(polygon)-1; i++ {
+		if cross2D(polygon[0], polygon[i], polygon[i+1]) != 0 {
+			res = append(res, [3]int{0, i, i + 1})
+		}
+	}
+	return res
+}
+
+// Divides the counterclockwise projected polygon into triangles by ear clipping.
+// Returns false if no ear can be found, which is possible only for a polygon that is not simple.
+func earClipping(polygon []point) ([][3]int, bool) {
+	var (
+		res     = make([][3]int, 0, len(polygon)-2)
+		indices = make([]int, len(polygon))
+	)
+	for i := range indices {
+		indices[i] = i
+	}
+	// Returns true if the triangle formed by the vertex and its neighbours does not contain other vertices.
+	var ear = func(k int) bool {
+		var (
+			n       = len(indices)
+			a, b, c = polygon[indices[(k+n-1)%n]], polygon[indices[k]], polygon[indices[(k+1)%n]]
+		)
+		if cross2D(a, b, c) <= 0 {
+			return false
+		}
+		for j := 0; j < n; j++ {
+			if j == k || j == (k+n-1)%n || j == (k+1)%n {
+				continue
+			}
+			var p = polygon[indices[j]]
+			if cross2D(a, b, p) >= 0 && cross2D(b, c, p) >= 0 && cross2D(c, a, p) >= 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for len(indices) > 3 {
+		var found = false
+		for k := range indices {
+			if ear(k) {
+				var n = len(indices)
+				res = append(res, [3]int{indices[(k+n-1)%n], indices[k], indices[(k+1)%n]})
+				indices = append(indices[:k], indices[k+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	if cross2D(polygon[indices[0]], polygon[indices[1]], polygon[indices[2]]) != 0 {
+		res = append(res, [3]int{indices[0], indices[1], indices[2]})
+	}
+	return res, true
+}
+
+// Divides the polygon into triangles in the specified way.
+// Returns the triangles as the indices of the vertices of the polygon and the problem found in the polygon.
+// The non-planar polygons are divided by their projection, the self-intersecting polygons are divided by a fan,
+// the degenerate polygons are not divided.
+func triangulate(polygon []point, t Triangulation) ([][3]int, polygonStatus) {
+	var (
+		normal = newellNormal(polygon)
+		area   = math.Sqrt(normal.x*normal.x+normal.y*normal.y+normal.z*normal.z) / 2
+		size   = polygonSize(polygon)
+		status = polygonCorrect
+	)
+	if area <= degeneracyTolerance*size*size {
+		return nil, polygonDegenerate
+	}
+	// The distance from the vertices to the plane passing through the first vertex.
+	var unit = point{normal.x / area / 2, normal.y / area / 2, normal.z / area / 2}
+	for _, p := range polygon[1:] {
+		var d = (p.x-polygon[0].x)*unit.x + (p.y-polygon[0].y)*unit.y + (p.z-polygon[0].z)*unit.z
+		if math.Abs(d) > planarityTolerance*size {
+			status = polygonNonPlanar
+			break
+		}
+	}
+	var projected = project(polygon, normal)
+	if !simple(projected) {
+		return fan(projected), polygonSelfIntersect
+	}
+	if t == Fan || convex(projected) {
+		return fan(projected), status
+	}
+	if res, ok := earClipping(projected); ok {
+		return res, status
+	}
+	return fan(projected), polygonSelfIntersect
+}
@@ -0,0 +1,72 @@
+package mtl
+
+// Describes a color in RGB format, each component takes values from 0 to 1.
+type Color struct {
+	R, G, B float64
+}
+
+// Describes a texture map statement: the name of the texture file and the options applied to it.
+type TextureMap struct {
+	File           string     // The name of the texture file.
+	Offset         [3]float64 // The offset of the texture origin (-o option).
+	Scale          [3]float64 // The scale of the texture pattern (-s option).
+	Turbulence     [3]float64 // The turbulence of the texture pattern (-t option).
+	Clamp          bool       // If true, the texture is clamped instead of being repeated (-clamp option).
+	BlendU         bool       // If true, the texture is blended in the horizontal direction (-blendu option).
+	BlendV         bool       // If true, the texture is blended in the vertical direction (-blendv option).
+	BumpMultiplier float64    // The multiplier for the values of the bump texture (-bm option).
+	Boost          float64    // The sharpness of the mip-mapped texture (-boost option).
+	Base, Gain     float64    // The modification of the texture values: base + gain*value (-mm option).
+	Channel        string     // The channel of the file used to create a scalar or bump texture (-imfchan option).
+	Type           string     // The type of the reflection map (-type option).
+}
+
+// Creates a new texture map with the default options.
+func NewTextureMap() *TextureMap {
+	return &TextureMap{
+		Scale:          [3]float64{1, 1, 1},
+		BlendU:         true,
+		BlendV:         true,
+		BumpMultiplier: 1,
+		Gain:           1,
+	}
+}
+
+// Describes a material defined by the newmtl statement and all the statements following it.
+type Material struct {
+	Name                string      // The name of the material.
+	Ambient             Color       // The ambient reflectivity (Ka statement).
+	Diffuse             Color       // The diffuse reflectivity (Kd statement).
+	Specular            Color       // The specular reflectivity (Ks statement).
+	Emissive            Color       // The emissive color (Ke statement).
+	TransmissionFilter  Color       // The transmission filter (Tf statement).
+	SpecularExponent    float64     // The focus of the specular highlight (Ns statement).
+	Dissolve            float64     // The opacity of the material, 1 is fully opaque (d and Tr statements).
+	OpticalDensity      float64     // The index of refraction (Ni statement).
+	Sharpness           float64     // The sharpness of the reflections (sharpness statement).
+	Illumination        int         // The number of the illumination model (illum statement).
+	AmbientMap          *TextureMap // The texture of the ambient reflectivity (map_Ka statement), nil if not specified.
+	DiffuseMap          *TextureMap // The texture of the diffuse reflectivity (map_Kd statement), nil if not specified.
+	SpecularMap         *TextureMap // The texture of the specular reflectivity (map_Ks statement), nil if not specified.
+	EmissiveMap         *TextureMap // The texture of the emissive color (map_Ke statement), nil if not specified.
+	SpecularExponentMap *TextureMap // The texture of the specular exponent (map_Ns statement), nil if not specified.
+	DissolveMap         *TextureMap // The texture of the dissolve (map_d statement), nil if not specified.
+	DecalMap            *TextureMap // The decal texture (decal statement), nil if not specified.
+	DisplacementMap     *TextureMap // The displacement texture (disp statement), nil if not specified.
+	BumpMap             *TextureMap // The bump texture (bump and map_bump statements), nil if not specified.
+	ReflectionMap       *TextureMap // The reflection texture (refl statement), nil if not specified.
+}
+
+// Creates a new material with the default values of the reflectivities and dissolve.
+func NewMaterial(name string) *Material {
+	return &Material{
+		Name:               name,
+		Ambient:            Color{0.2, 0.2, 0.2},
+		Diffuse:            Color{0.8, 0.8, 0.8},
+		Specular:           Color{1, 1, 1},
+		TransmissionFilter: Color{1, 1, 1},
+		Dissolve:           1,
+		OpticalDensity:     1,
+		Sharpness:          60,
+	}
+}
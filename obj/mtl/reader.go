@@ -0,0 +1,413 @@
+package mtl
+
+import (
+	"computer_graphics/obj/parser"
+	"computer_graphics/obj/scanner"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// An error that does not prevent reading the statement or the material, it is output as a warning.
+type warning string
+
+// Implementation of the Error method in the error interface.
+func (w warning) Error() string { return string(w) }
+
+// Reads the statement of the material from its arguments.
+type statement func(m *Material, args []string) error
+
+// Converts the argument to float64.
+func parseFloat(name, arg string) (float64, error) {
+	var res, err = strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert the %s to a float: %s", name, arg)
+	}
+	return res, nil
+}
+
+// Converts the arguments to float64.
+// Reads at most max arguments, stops at the first argument that is not a number.
+// Returns an error if less than min arguments are read.
+func parseFloats(name string, args []string, min, max int) ([]float64, error) {
+	var res = make([]float64, 0, max)
+	for _, arg := range args {
+		if len(res) == max {
+			break
+		}
+		var val, err = strconv.ParseFloat(arg, 64)
+		if err != nil {
+			break
+		}
+		res = append(res, val)
+	}
+	if len(res) < min {
+		return nil, fmt.Errorf("the %s must contain at least %d numbers", name, min)
+	}
+	return res, nil
+}
+
+// Converts the on or off argument to bool.
+func parseSwitch(name, arg string) (bool, error) {
+	switch arg {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("the %s must take the values 'on' or 'off', received: %s", name, arg)
+}
+
+// Returns the statement that reads a single float value into the field returned by the field function.
+func floatStatement(name string, field func(m *Material) *float64) statement {
+	return func(m *Material, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("the %s statement must contain exactly one value", name)
+		}
+		var val, err = parseFloat(name, args[0])
+		if err != nil {
+			return err
+		}
+		*field(m) = val
+		return nil
+	}
+}
+
+// Returns the statement that reads a color into the field returned by the field function.
+// If only one component is specified, it is used for all three components.
+func colorStatement(name string, field func(m *Material) *Color) statement {
+	return func(m *Material, args []string) error {
+		if len(args) > 0 && (args[0] == "spectral" || args[0] == "xyz") {
+			return warning(fmt.Sprintf("%s colors are not supported, the statement will be skipped", args[0]))
+		}
+		var values, err = parseFloats(name, args, 1, 3)
+		if err != nil {
+			return err
+		}
+		if len(values) != len(args) || len(values) == 2 {
+			return fmt.Errorf("the %s statement must contain one or three numbers", name)
+		}
+		if len(values) == 1 {
+			*field(m) = Color{values[0], values[0], values[0]}
+		} else {
+			*field(m) = Color{values[0], values[1], values[2]}
+		}
+		return nil
+	}
+}
+
+// Reads the option of the texture map statement from its arguments.
+// Returns the number of the arguments used.
+type textureOption func(t *TextureMap, args []string) (int, error)
+
+// Returns the texture option that reads from one to three numbers into the field returned by the field function.
+// The omitted numbers keep their values.
+func vectorOption(name string, field func(t *TextureMap) *[3]float64) textureOption {
+	return func(t *TextureMap, args []string) (int, error) {
+		var values, err = parseFloats(name, args, 1, 3)
+		if err != nil {
+			return 0, err
+		}
+		copy(field(t)[:], values)
+		return len(values), nil
+	}
+}
+
+// Returns the texture option that reads on or off into the field returned by the field function.
+func switchOption(name string, field func(t *TextureMap) *bool) textureOption {
+	return func(t *TextureMap, args []string) (int, error) {
+		if len(args) == 0 {
+			return 0, fmt.Errorf("the %s is not specified", name)
+		}
+		var val, err = parseSwitch(name, args[0])
+		if err != nil {
+			return 0, err
+		}
+		*field(t) = val
+		return 1, nil
+	}
+}
+
+// Returns the texture option that reads a single number into the field returned by the field function.
+func floatOption(name string, field func(t *TextureMap) *float64) textureOption {
+	return func(t *TextureMap, args []string) (int, error) {
+		var values, err = parseFloats(name, args, 1, 1)
+		if err != nil {
+			return 0, err
+		}
+		*field(t) = values[0]
+		return 1, nil
+	}
+}
+
+// Returns the texture option that reads a word from the allowed values into the field returned by the field function.
+func wordOption(name string, allowed []string, field func(t *TextureMap) *string) textureOption {
+	return func(t *TextureMap, args []string) (int, error) {
+		if len(args) == 0 {
+			return 0, fmt.Errorf("the %s is not specified", name)
+		}
+		for _, val := range allowed {
+			if args[0] == val {
+				*field(t) = val
+				return 1, nil
+			}
+		}
+		return 0, fmt.Errorf("the %s must take one of the values %s, received: %s", name, strings.Join(allowed, ", "), args[0])
+	}
+}
+
+// The options of the texture map statements by their names.
+var textureOptions = map[string]textureOption{
+	"-o":      vectorOption("origin offset", func(t *TextureMap) *[3]float64 { return &t.Offset }),
+	"-s":      vectorOption("scale", func(t *TextureMap) *[3]float64 { return &t.Scale }),
+	"-t":      vectorOption("turbulence", func(t *TextureMap) *[3]float64 { return &t.Turbulence }),
+	"-clamp":  switchOption("clamp", func(t *TextureMap) *bool { return &t.Clamp }),
+	"-blendu": switchOption("horizontal blending", func(t *TextureMap) *bool { return &t.BlendU }),
+	"-blendv": switchOption("vertical blending", func(t *TextureMap) *bool { return &t.BlendV }),
+	"-bm":     floatOption("bump multiplier", func(t *TextureMap) *float64 { return &t.BumpMultiplier }),
+	"-boost":  floatOption("boost", func(t *TextureMap) *float64 { return &t.Boost }),
+	"-mm": func(t *TextureMap, args []string) (int, error) {
+		var values, err = parseFloats("range modification", args, 2, 2)
+		if err != nil {
+			return 0, err
+		}
+		t.Base, t.Gain = values[0], values[1]
+		return 2, nil
+	},
+	"-imfchan": wordOption("channel", []string{"r", "g", "b", "m", "l", "z"}, func(t *TextureMap) *string {
+		return &t.Channel
+	}),
+	"-type": wordOption(
+		"reflection map type",
+		[]string{"sphere", "cube_top", "cube_bottom", "cube_front", "cube_back", "cube_left", "cube_right"},
+		func(t *TextureMap) *string { return &t.Type },
+	),
+	// The color correction and the texture resolution only affect the way the texture is loaded.
+	"-cc": func(t *TextureMap, args []string) (int, error) {
+		if len(args) == 0 {
+			return 0, errors.New("the color correction is not specified")
+		}
+		var _, err = parseSwitch("color correction", args[0])
+		return 1, err
+	},
+	"-texres": func(t *TextureMap, args []string) (int, error) {
+		var _, err = parseFloats("texture resolution", args, 1, 1)
+		return 1, err
+	},
+}
+
+// Returns the statement that reads a texture map into the field returned by the field function.
+// The options precede the name of the file, the name of the file may contain spaces.
+func textureStatement(field func(m *Material) **TextureMap) statement {
+	return func(m *Material, args []string) error {
+		var t = NewTextureMap()
+		for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+			var option, ok = textureOptions[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown texture option: %s", args[0])
+			}
+			var n, err = option(t, args[1:])
+			if err != nil {
+				return err
+			}
+			args = args[n+1:]
+		}
+		if len(args) == 0 {
+			return errors.New("the name of the texture file is not specified")
+		}
+		t.File = strings.Join(args, " ")
+		*field(m) = t
+		return nil
+	}
+}
+
+// The statements of the material by their names.
+var statements = map[string]statement{
+	"Ka": colorStatement("Ka", func(m *Material) *Color { return &m.Ambient }),
+	"Kd": colorStatement("Kd", func(m *Material) *Color { return &m.Diffuse }),
+	"Ks": colorStatement("Ks", func(m *Material) *Color { return &m.Specular }),
+	"Ke": colorStatement("Ke", func(m *Material) *Color { return &m.Emissive }),
+	"Tf": colorStatement("Tf", func(m *Material) *Color { return &m.TransmissionFilter }),
+	"Ns": floatStatement("Ns", func(m *Material) *float64 { return &m.SpecularExponent }),
+	"Ni": floatStatement("Ni", func(m *Material) *float64 { return &m.OpticalDensity }),
+	"d": func(m *Material, args []string) error {
+		// The halo effect depends on the viewing angle and cannot be described by a single value.
+		if len(args) > 0 && args[0] == "-halo" {
+			args = args[1:]
+		}
+		return floatStatement("d", func(m *Material) *float64 { return &m.Dissolve })(m, args)
+	},
+	"Tr": func(m *Material, args []string) error {
+		var transparency float64
+		var err = floatStatement("Tr", func(m *Material) *float64 { return &transparency })(m, args)
+		if err == nil {
+			m.Dissolve = 1 - transparency
+		}
+		return err
+	},
+	"sharpness": floatStatement("sharpness", func(m *Material) *float64 { return &m.Sharpness }),
+	"illum": func(m *Material, args []string) error {
+		if len(args) != 1 {
+			return errors.New("the illum statement must contain exactly one value")
+		}
+		var val, err = strconv.Atoi(args[0])
+		if err != nil || val < 0 || val > 10 {
+			return fmt.Errorf("the illumination model must be an integer from 0 to 10, received: %s", args[0])
+		}
+		m.Illumination = val
+		return nil
+	},
+	"map_Ka":   textureStatement(func(m *Material) **TextureMap { return &m.AmbientMap }),
+	"map_Kd":   textureStatement(func(m *Material) **TextureMap { return &m.DiffuseMap }),
+	"map_Ks":   textureStatement(func(m *Material) **TextureMap { return &m.SpecularMap }),
+	"map_Ke":   textureStatement(func(m *Material) **TextureMap { return &m.EmissiveMap }),
+	"map_Ns":   textureStatement(func(m *Material) **TextureMap { return &m.SpecularExponentMap }),
+	"map_d":    textureStatement(func(m *Material) **TextureMap { return &m.DissolveMap }),
+	"decal":    textureStatement(func(m *Material) **TextureMap { return &m.DecalMap }),
+	"disp":     textureStatement(func(m *Material) **TextureMap { return &m.DisplacementMap }),
+	"bump":     textureStatement(func(m *Material) **TextureMap { return &m.BumpMap }),
+	"map_bump": textureStatement(func(m *Material) **TextureMap { return &m.BumpMap }),
+	"map_Bump": textureStatement(func(m *Material) **TextureMap { return &m.BumpMap }),
+	"refl":     textureStatement(func(m *Material) **TextureMap { return &m.ReflectionMap }),
+}
+
+// Allows you to read the materials from a .mtl file.
+// Reports the problems that occur during reading as diagnostics.
+// You can disable the reporting by using the IgnoreWarnings and IgnoreErrors fields.
+// You can also specify io.Writer to output the text representation of the diagnostics to
+// and a parser.DiagnosticHandler to receive them.
+type Reader struct {
+	Output         io.Writer                // Recipient of error and warning messages.
+	Handler        parser.DiagnosticHandler // Recipient of the diagnostics in addition to the Output, nil if not set.
+	IgnoreWarnings bool                     // If true, no warning messages will be reported.
+	IgnoreErrors   bool                     // If true, no error messages will be reported.
+
+	file string // The name of the file being read, empty if it is read by the Read method.
+}
+
+// Delivers the diagnostic to the Output and the Handler if it is not ignored.
+// The diagnostics refer to the material library statement of the .obj file.
+func (r *Reader) report(severity parser.Severity, line int, msg string) {
+	if severity == parser.Warning && r.IgnoreWarnings || severity == parser.Error && r.IgnoreErrors {
+		return
+	}
+	var d = parser.Diagnostic{
+		Severity:    severity,
+		File:        r.file,
+		Line:        line,
+		ElementType: parser.MaterialLibrary,
+		Message:     msg,
+	}
+	if r.Output != nil {
+		// The Output receives the messages of a single file, so the name of the file is not repeated in them.
+		var text = d
+		text.File = ""
+		parser.WriteDiagnostic(r.Output, text)
+	}
+	if r.Handler != nil {
+		r.Handler(d)
+	}
+}
+
+// Reports a warning diagnostic about the line.
+func (r *Reader) warning(line int, msg string) {
+	r.report(parser.Warning, line, msg)
+}
+
+// Reports an error diagnostic about the line.
+func (r *Reader) error(line int, msg string) {
+	r.report(parser.Error, line, msg)
+}
+
+// Reads the values of the next line separated by spaces.
+// The tokens between spaces are combined, so the values may contain any characters except spaces.
+// Returns true as the second value if the end of the input is reached.
+func readLine(s scanner.Scanner) ([]string, bool) {
+	var (
+		values []string
+		value  strings.Builder
+	)
+	var flush = func() {
+		if value.Len() > 0 {
+			values = append(values, value.String())
+			value.Reset()
+		}
+	}
+	for {
+		var tokenType, token = s.Next()
+		switch tokenType {
+		case scanner.Space:
+			flush()
+		case scanner.EOL:
+			flush()
+			return values, false
+		case scanner.EOF:
+			flush()
+			return values, true
+		default:
+			value.WriteString(token)
+		}
+	}
+}
+
+// Reads all the materials from io.Reader in the order of their definition.
+// Handles errors according to the settings in the fields.
+// The statement containing an error is skipped, the rest of the material is read.
+func (r *Reader) Read(in io.Reader) []*Material {
+	var (
+		s        = scanner.NewScanner(in)
+		res      []*Material
+		material *Material
+	)
+	for {
+		var values, eof = readLine(s)
+		// The scanner counts the lines from zero.
+		var line = s.Line() + 1
+		if len(values) > 0 {
+			switch values[0] {
+			case "newmtl":
+				if len(values) < 2 {
+					r.error(line, "the name of the material is not specified, the material will be skipped")
+					material = nil
+				} else {
+					material = NewMaterial(strings.Join(values[1:], " "))
+					res = append(res, material)
+				}
+			default:
+				var st, ok = statements[values[0]]
+				if !ok {
+					r.warning(line, fmt.Sprintf("unsupported statement: %s, the line will be skipped", values[0]))
+				} else if material == nil {
+					r.error(line, fmt.Sprintf("the %s statement does not follow any material, the line will be skipped", values[0]))
+				} else if err := st(material, values[1:]); err != nil {
+					var w warning
+					if errors.As(err, &w) {
+						r.warning(line, w.Error())
+					} else {
+						r.error(line, err.Error()+", the line will be skipped")
+					}
+				}
+			}
+		}
+		if eof {
+			return res
+		}
+	}
+}
+
+// Reads all the materials from the file with the specified name.
+// The diagnostics contain the name of the file.
+// Returns an error if the file cannot be opened.
+func (r *Reader) ReadFile(name string) ([]*Material, error) {
+	var file, err = os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var rd = *r
+	rd.file = name
+	return rd.Read(file), nil
+}
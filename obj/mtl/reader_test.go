@@ -0,0 +1,58 @@
+package mtl
+
+import (
+	"computer_graphics/obj/parser"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Reads all materials from a file containing errors and unsupported statements.
+func ExampleReader_ReadFile() {
+	var (
+		reader         = Reader{Output: os.Stdout}
+		materials, err = reader.ReadFile("testdata/materials.mtl")
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, m := range materials {
+		fmt.Printf("%s : Ka: %v, Kd: %v, Ks: %v, Ns: %v, d: %v, Ni: %v, illum: %d\n",
+			m.Name, m.Ambient, m.Diffuse, m.Specular, m.SpecularExponent, m.Dissolve, m.OpticalDensity, m.Illumination)
+		if m.DiffuseMap != nil {
+			fmt.Printf("map_Kd : %s, scale: %v, clamp: %v\n", m.DiffuseMap.File, m.DiffuseMap.Scale, m.DiffuseMap.Clamp)
+		}
+		if m.BumpMap != nil {
+			fmt.Printf("bump : %s, multiplier: %v\n", m.BumpMap.File, m.BumpMap.BumpMultiplier)
+		}
+	}
+	// Output:
+	// [ERROR] line: 2, message: the Kd statement does not follow any material, the line will be skipped
+	// [WARNING] line: 14, message: spectral colors are not supported, the statement will be skipped
+	// [ERROR] line: 18, message: the Ks statement must contain one or three numbers, the line will be skipped
+	// [ERROR] line: 19, message: the illumination model must be an integer from 0 to 10, received: 12, the line will be skipped
+	// [WARNING] line: 20, message: unsupported statement: Pr, the line will be skipped
+	// [ERROR] line: 21, message: unknown texture option: -q, the line will be skipped
+	// [ERROR] line: 22, message: the name of the material is not specified, the material will be skipped
+	// Shiny_Plastic : Ka: {0.1 0.1 0.1}, Kd: {0.8 0.2 0.2}, Ks: {1 1 1}, Ns: 96.078431, d: 0.5, Ni: 1, illum: 2
+	// map_Kd : textures/plastic diffuse.png, scale: [2 2 1], clamp: true
+	// fox.material : Ka: {0.2 0.2 0.2}, Kd: {0.878 0.353 0}, Ks: {1 1 1}, Ns: 0, d: 0.75, Ni: 1.45, illum: 0
+	// bump : fox-bump.png, multiplier: 0.5
+}
+
+// Collects the problems as diagnostics referring to the material library statement.
+func ExampleReader_Read_handler() {
+	var (
+		collector parser.Collector
+		reader    = Reader{Handler: collector.Handle, IgnoreWarnings: true}
+		materials = reader.Read(strings.NewReader("newmtl red\nKd 1 0 0\nPr 0.5\nillum x\n"))
+	)
+	fmt.Println("materials:", len(materials), "diffuse:", materials[0].Diffuse)
+	for _, d := range collector.Diagnostics {
+		fmt.Printf("%s, line: %d, element: %s, message: %s\n", d.Severity, d.Line, d.ElementType, d.Message)
+	}
+	// Output:
+	// materials: 1 diffuse: {1 0 0}
+	// ERROR, line: 4, element: material library, message: the illumination model must be an integer from 0 to 10, received: x, the line will be skipped
+}
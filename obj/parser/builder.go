@@ -87,6 +87,9 @@ type finiteStateMachine struct {
 	matrix  [][scanner.TokensCount]stateType // The transition table.
 	actions []action                         // An array of actions that are performed when transitioning to a certain state.
 	errors  [][scanner.TokensCount]string    // Array of error messages returned when transitioning to the err state.
+	modes   []scanner.Mode                   // An array of modes in which the scanner reads the token following a certain state.
+	setters []setter                         // The setters whose actions are performed in the states, nil for the other states.
+	checks  []*endCheck                      // The checks performed when the end of the line is read in the states, nil for the other states.
 }
 
 // Clears the element of finiteStateMachine to read the new line.
@@ -108,9 +111,23 @@ func (m *finiteStateMachine) message(tokenType scanner.TokenType, state stateTyp
 	return m.errors[state][tokenType]
 }
 
+// Implementation of the mode method in the elementParser interface.
+func (m *finiteStateMachine) mode(state stateType) scanner.Mode { return m.modes[state] }
+
+// Implementation of the check method in the elementParser interface.
+func (m *finiteStateMachine) check(state stateType) error {
+	if c := m.checks[state]; c != nil && c.condition.holds(m.element.Elem()) {
+		return errors.New(c.message)
+	}
+	return nil
+}
+
 // Implementation of the result method in the elementParser interface.
 func (m *finiteStateMachine) result() interface{} { return m.element.Interface() }
 
+// Implementation of the resultType method in the structParser interface.
+func (m *finiteStateMachine) resultType() reflect.Type { return m.element.Type() }
+
 // Creates a new finiteStateMachine that reads the specified element and has the specified size of the transition table.
 func newMachine(element reflect.Value, size int) *finiteStateMachine {
 	return &finiteStateMachine{
@@ -118,6 +135,9 @@ func newMachine(element reflect.Value, size int) *finiteStateMachine {
 		matrix:  make([][scanner.TokensCount]stateType, size),
 		actions: make([]action, size),
 		errors:  make([][scanner.TokensCount]string, size),
+		modes:   make([]scanner.Mode, size),
+		setters: make([]setter, size),
+		checks:  make([]*endCheck, size),
 	}
 }
 
@@ -128,6 +148,8 @@ type setter interface {
 	set(token string, value reflect.Value) error
 	// Returns the type of the token that can be converted to the required type.
 	expected() scanner.TokenType
+	// Returns the mode in which the scanner must read the token.
+	mode() scanner.Mode
 }
 
 // setter for converting on/off values to bool and writing to reflect.Value.
@@ -151,6 +173,9 @@ func (s *boolSetter) set(token string, value reflect.Value) error {
 // Implementation of the expected method in the setter interface.
 func (s *boolSetter) expected() scanner.TokenType { return scanner.Word }
 
+// Implementation of the mode method in the setter interface.
+func (s *boolSetter) mode() scanner.Mode { return scanner.Tokens }
+
 // Implementation of the changeName method in the setter interface.
 func (s *boolSetter) changeName(name string) {
 	s.error = fmt.Errorf("the %s parameter must take the values 'on' or 'off'", name)
@@ -184,6 +209,9 @@ func (s *directionTypeSetter) set(token string, value reflect.Value) error {
 // Implementation of the expected method in the setter interface.
 func (s *directionTypeSetter) expected() scanner.TokenType { return scanner.Word }
 
+// Implementation of the mode method in the setter interface.
+func (s *directionTypeSetter) mode() scanner.Mode { return scanner.Tokens }
+
 // Implementation of the changeName method in the setter interface.
 func (s *directionTypeSetter) changeName(name string) {
 	s.error = fmt.Errorf("the %s parameter must take the values 'v' or 'u'", name)
@@ -214,6 +242,9 @@ func (s *intSetter) set(token string, value reflect.Value) error {
 // Implementation of the expected method in the setter interface.
 func (s *intSetter) expected() scanner.TokenType { return scanner.Integer }
 
+// Implementation of the mode method in the setter interface.
+func (s *intSetter) mode() scanner.Mode { return scanner.Tokens }
+
 // Creates a new intSetter by the parameter name.
 func newIntSetter(name string) *intSetter {
 	return &intSetter{fmt.Errorf("failed to convert the token to an integer when reading %s", name)}
@@ -237,13 +268,19 @@ func (s *floatSetter) set(token string, value reflect.Value) error {
 // Implementation of the expected method in the setter interface.
 func (s *floatSetter) expected() scanner.TokenType { return scanner.Float }
 
+// Implementation of the mode method in the setter interface.
+func (s *floatSetter) mode() scanner.Mode { return scanner.Tokens }
+
 // Creates a new floatSetter by the parameter name.
 func newFloatSetter(name string) *floatSetter {
 	return &floatSetter{fmt.Errorf("failed to convert the token to a float when reading %s", name)}
 }
 
 // setter for writing string values to reflect.Value.
-type stringSetter struct{}
+// The values are read as names or as the rest of the line, so they can contain any characters.
+type stringSetter struct {
+	tokenMode scanner.Mode // The mode in which the value is read.
+}
 
 // Implementation of the set method in the setter interface.
 func (s *stringSetter) set(token string, value reflect.Value) error {
@@ -252,10 +289,103 @@ func (s *stringSetter) set(token string, value reflect.Value) error {
 }
 
 // Implementation of the expected method in the setter interface.
-func (s *stringSetter) expected() scanner.TokenType { return scanner.Float }
+func (s *stringSetter) expected() scanner.TokenType { return scanner.Word }
+
+// Implementation of the mode method in the setter interface.
+func (s *stringSetter) mode() scanner.Mode { return s.tokenMode }
+
+// Creates a new stringSetter that reads the value in the specified mode.
+func newStringSetter(mode scanner.Mode) *stringSetter { return &stringSetter{tokenMode: mode} }
+
+// setter for converting one of the words of the enum tag to the number of the word and writing to reflect.Value.
+// The value can be of any integer type.
+type enumSetter struct {
+	words []string // The possible words in the order of their numbers.
+	error error    // Enum parsing error message.
+}
+
+// Implementation of the set method in the setter interface.
+func (s *enumSetter) set(token string, value reflect.Value) error {
+	for i, word := range s.words {
+		if word == token {
+			switch value.Kind() {
+			case reflect.Uint8:
+				value.SetUint(uint64(i))
+			default:
+				value.SetInt(int64(i))
+			}
+			return nil
+		}
+	}
+	return s.error
+}
+
+// Implementation of the expected method in the setter interface.
+func (s *enumSetter) expected() scanner.TokenType { return scanner.Word }
 
-// Creates a new stringSetter.
-func newStringSetter() *stringSetter { return &stringSetter{} }
+// Implementation of the mode method in the setter interface.
+func (s *enumSetter) mode() scanner.Mode { return scanner.Tokens }
+
+// Creates a new enumSetter by the parameter name and the possible words.
+func newEnumSetter(name string, words []string) *enumSetter {
+	return &enumSetter{words: words, error: fmt.Errorf("the %s parameter must take the values %s", name, quoteWords(words))}
+}
+
+// setter for converting integer values or the words of the union tag to int and writing to reflect.Value.
+// Each word is converted to the integer specified for it in the tag.
+type unionSetter struct {
+	intSetter                // Converts the integer values.
+	values    map[string]int // The integers corresponding to the words.
+	error     error          // Word parsing error message.
+}
+
+// Implementation of the set method in the setter interface.
+func (s *unionSetter) set(token string, value reflect.Value) error {
+	if val, ok := s.values[token]; ok {
+		value.SetInt(int64(val))
+		return nil
+	}
+	// Only the words and the integers are passed to the setter.
+	if _, err := strconv.Atoi(token); err != nil {
+		return s.error
+	}
+	return s.intSetter.set(token, value)
+}
+
+// Returns true, because the union parameter accepts the scanner.Word tokens in addition to the expected ones.
+func (s *unionSetter) acceptsWords() bool { return true }
+
+// Creates a new unionSetter by the parameter name, the possible words and the integers corresponding to them.
+func newUnionSetter(name string, words []string, values map[string]int) *unionSetter {
+	return &unionSetter{
+		intSetter: *newIntSetter(name),
+		values:    values,
+		error:     fmt.Errorf("the %s parameter must be an integer or take the values %s", name, quoteWords(words)),
+	}
+}
+
+// Implemented by the setters that accept the scanner.Word tokens in addition to the expected tokens.
+type wordsAcceptor interface {
+	acceptsWords() bool
+}
+
+// Returns true if the setter or the setter wrapped by it accepts the scanner.Word tokens in addition to the expected tokens.
+func acceptsWords(s setter) bool {
+	var acceptor, ok = s.(wordsAcceptor)
+	return ok && acceptor.acceptsWords()
+}
+
+// Returns the words in quotes separated by commas and the "or" before the last word.
+func quoteWords(words []string) string {
+	var quoted = make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = "'" + word + "'"
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
 
 // Wrapper for writing a value to the desired field of the structure.
 // Retrieves the desired field and delegates writing to it to the nested setter.
@@ -269,6 +399,9 @@ func (s *structSetter) set(token string, value reflect.Value) error {
 	return s.setter.set(token, value.Field(s.fieldNumber))
 }
 
+// Implementation of the acceptsWords method in the wordsAcceptor interface.
+func (s *structSetter) acceptsWords() bool { return acceptsWords(s.setter) }
+
 // Creates a new structSetter.
 func newStructSetter(fieldNumber int, setter setter) *structSetter {
 	return &structSetter{
@@ -277,6 +410,103 @@ func newStructSetter(fieldNumber int, setter setter) *structSetter {
 	}
 }
 
+// setter for reading the word of the flag tag or the value of the field following the flag field.
+// The word sets the flag field to true, any other token is written by the setter of the next field.
+type flagSetter struct {
+	word        string // The word of the flag tag.
+	fieldNumber int    // The number of the flag field.
+	next        setter // Writes the token to the field following the flag field.
+}
+
+// Implementation of the set method in the setter interface.
+func (s *flagSetter) set(token string, value reflect.Value) error {
+	if token == s.word {
+		value.Field(s.fieldNumber).SetBool(true)
+		return nil
+	}
+	return s.next.set(token, value)
+}
+
+// Implementation of the expected method in the setter interface.
+func (s *flagSetter) expected() scanner.TokenType { return scanner.Word }
+
+// Implementation of the mode method in the setter interface.
+func (s *flagSetter) mode() scanner.Mode { return scanner.Tokens }
+
+// Creates a new flagSetter of the flag field with the specified number and word.
+func newFlagSetter(word string, fieldNumber int, next setter) *flagSetter {
+	return &flagSetter{
+		word:        word,
+		fieldNumber: fieldNumber,
+		next:        next,
+	}
+}
+
+// A condition on the field of the structure read before the current token:
+// the flag field is true or the field with the enum or union tag takes one of the values of the words.
+type condition struct {
+	fieldNumber int   // The number of the field.
+	values      []int // The values of the words of the enum or union field, nil for the flag field.
+	negated     bool  // true if the condition holds when the field does not take the values.
+}
+
+// Returns true if the field of the structure satisfies the condition.
+func (c condition) holds(value reflect.Value) bool {
+	var (
+		field  = value.Field(c.fieldNumber)
+		number int
+	)
+	switch field.Kind() {
+	case reflect.Bool:
+		return field.Bool()
+	case reflect.Uint8:
+		number = int(field.Uint())
+	default:
+		number = int(field.Int())
+	}
+	for _, v := range c.values {
+		if v == number {
+			return !c.negated
+		}
+	}
+	return c.negated
+}
+
+// Wrapper for writing a value only if the condition on the fields read before it holds.
+// Returns the error otherwise.
+type conditionalSetter struct {
+	setter              // Delegate.
+	condition condition // The condition on the fields read before the value.
+	error     error     // The error returned if the condition does not hold.
+}
+
+// Implementation of the set method in the setter interface.
+func (s *conditionalSetter) set(token string, value reflect.Value) error {
+	if !s.condition.holds(value) {
+		return s.error
+	}
+	return s.setter.set(token, value)
+}
+
+// Implementation of the acceptsWords method in the wordsAcceptor interface.
+func (s *conditionalSetter) acceptsWords() bool { return acceptsWords(s.setter) }
+
+// Creates a new conditionalSetter.
+func newConditionalSetter(condition condition, setter setter, err error) *conditionalSetter {
+	return &conditionalSetter{
+		setter:    setter,
+		condition: condition,
+		error:     err,
+	}
+}
+
+// A check of the element performed when the end of the line is read in a certain state.
+// The line cannot end in the state if the condition holds.
+type endCheck struct {
+	condition condition // The condition on the fields read before the end of the line.
+	message   string    // The message of the error returned if the condition holds.
+}
+
 // Wrapper for writing a value to the last element of the slice.
 // Retrieves the desired element and delegates writing to it to the nested setter.
 type sliceSetter struct {
@@ -288,6 +518,9 @@ func (s *sliceSetter) set(token string, value reflect.Value) error {
 	return s.setter.set(token, value.Index(value.Len()-1))
 }
 
+// Implementation of the acceptsWords method in the wordsAcceptor interface.
+func (s *sliceSetter) acceptsWords() bool { return acceptsWords(s.setter) }
+
 // Creates a new sliceSetter.
 func newSliceSetter(setter setter) *sliceSetter {
 	return &sliceSetter{
@@ -307,6 +540,9 @@ func (s *sliceAppender) set(token string, value reflect.Value) error {
 	return s.setter.set(token, value)
 }
 
+// Implementation of the acceptsWords method in the wordsAcceptor interface.
+func (s *sliceAppender) acceptsWords() bool { return acceptsWords(s.setter) }
+
 // Creates a new sliceAppender.
 func newSliceAppender(setter setter) *sliceAppender {
 	return &sliceAppender{
@@ -314,6 +550,87 @@ func newSliceAppender(setter setter) *sliceAppender {
 	}
 }
 
+// Returns the number of the optional fields and the number of the fields of the optional group
+// that take the specified number of values read at the end of the line.
+// The group takes the last values as soon as there are enough of them, the optional fields take the values before them.
+// If there are fewer values than the fields of the group but more than the optional fields,
+// they are written to the first fields of the group, which are not read completely.
+func trailingLayout(count, optional, size int) (int, int) {
+	switch {
+	case count >= size:
+		return count - size, size
+	case count <= optional:
+		return count, 0
+	default:
+		return 0, count
+	}
+}
+
+// setter for reading the optional float64 fields followed by the optional pointer to the structure with float64 fields,
+// the group of fields that is read all or none, see the trailingLayout function for the places of the values.
+// Since the places depend on the number of the values, the setter of each value moves the values read before it.
+type trailingSetter struct {
+	floatSetter       // Converts the token to float64.
+	fields      []int // The numbers of the optional float64 fields preceding the pointer.
+	pointer     int   // The number of the field of the pointer.
+	size        int   // The number of the fields of the structure the pointer points to.
+	read        int   // The number of the values read before the token.
+}
+
+// Returns the fields of the structure in which the specified number of values is written.
+// The structure the pointer points to is created if the values are written to it.
+func (s *trailingSetter) places(value reflect.Value, count int) []reflect.Value {
+	var (
+		fields, group = trailingLayout(count, len(s.fields), s.size)
+		pointer       = value.Field(s.pointer)
+		res           = make([]reflect.Value, 0, count)
+	)
+	for _, field := range s.fields[:fields] {
+		res = append(res, value.Field(field))
+	}
+	if group > 0 && pointer.IsNil() {
+		pointer.Set(reflect.New(pointer.Type().Elem()))
+	}
+	for i := 0; i < group; i++ {
+		res = append(res, pointer.Elem().Field(i))
+	}
+	return res
+}
+
+// Implementation of the set method in the setter interface.
+func (s *trailingSetter) set(token string, value reflect.Value) error {
+	var val, err = strconv.ParseFloat(token, 64)
+	if err != nil {
+		return s.error
+	}
+	var values = make([]float64, 0, s.read+1)
+	for _, place := range s.places(value, s.read) {
+		values = append(values, place.Float())
+	}
+	for _, field := range s.fields {
+		value.Field(field).SetFloat(0)
+	}
+	for i, place := range s.places(value, s.read+1) {
+		if i < s.read {
+			place.SetFloat(values[i])
+		} else {
+			place.SetFloat(val)
+		}
+	}
+	return nil
+}
+
+// Creates a new trailingSetter of the value following the specified number of values.
+func newTrailingSetter(name string, fields []int, pointer, size, read int) *trailingSetter {
+	return &trailingSetter{
+		floatSetter: *newFloatSetter(name),
+		fields:      fields,
+		pointer:     pointer,
+		size:        size,
+		read:        read,
+	}
+}
+
 // Interface for building states for a single value.
 type parameter interface {
 	// Creates states in builder for reading a single value.
@@ -340,10 +657,14 @@ type baseParameter struct {
 func (p *baseParameter) baseUpdate(b *rowBuilder, state stateType, unread []string) {
 	var (
 		expected = p.setter.expected()
-		act      = p.setter.set
+		act      = p.setter
 	)
+	b.mode = p.setter.mode()
 	if expected == scanner.Word {
 		b.onWord(state, act)
+	} else if acceptsWords(p.setter) {
+		// The action is recorded when processing the transition by the expected token.
+		b.onWord(state, nil)
 	} else {
 		b.onWordError(invalidTokenMessage(p.String(), expected, scanner.Word))
 	}
@@ -459,6 +780,7 @@ func newStructParameter(name string, delimiter scanner.TokenType, size int) *str
 type sliceParameter struct {
 	parameterName     // The name of the sliceParameter.
 	min           int // Minimum number of slice elements.
+	max           int // Maximum number of slice elements, 0 if the number is not limited.
 }
 
 // Implementation of the String method in the parameter interface.
@@ -478,6 +800,11 @@ func (p *sliceParameter) names() []string {
 	return res
 }
 
+// Returns a string with a message that the slice contains more elements than the maximum number.
+func (p *sliceParameter) tooManyMessage() string {
+	return fmt.Sprintf("the number of the %s cannot exceed %d", p, p.max)
+}
+
 // A parameter that generates states for reading the slice of types that requires only one state of the finite state machine.
 type baseSliceParameter struct {
 	sliceParameter                // Basic structure.
@@ -501,6 +828,16 @@ func (p *baseSliceParameter) update(b *builder) {
 			b.waitSpace(tokenAfter(name), []string{})
 		}
 	}
+	if p.max > 0 {
+		// Create states for reading the optional slice elements up to the maximum number.
+		for i := p.min; i < p.max; i++ {
+			name = p.name(i)
+			p.param.baseUpdate(b.nextParameterRow(name, expected), b.nextState(), []string{})
+			b.waitSpace(tokenAfter(name), []string{})
+		}
+		b.waitEnd(p.tooManyMessage())
+		return
+	}
 	// Create additional states for reading an arbitrary number of slice elements after the required ones.
 	var loopState = b.nextState()
 	name = fmt.Sprintf("additional %s", p.parameterName)
@@ -513,11 +850,12 @@ func (p *baseSliceParameter) update(b *builder) {
 }
 
 // Creates a new baseSliceParameter.
-func newBaseSliceParameter(name string, min int, param *baseParameter) *baseSliceParameter {
+func newBaseSliceParameter(name string, min, max int, param *baseParameter) *baseSliceParameter {
 	return &baseSliceParameter{
 		sliceParameter: sliceParameter{
 			parameterName: parameterName(name),
 			min:           min,
+			max:           max,
 		},
 		param: param,
 	}
@@ -661,7 +999,11 @@ func (p *structSliceParameter) updateRecursive(
 		}
 	} else {
 		// All parameters processed, exit from recursion.
-		b.waitSpace(delimiterBetween(sliceNames[0], sliceNames[1]), sliceNames[1:])
+		if p.min > 1 {
+			b.waitSpace(delimiterBetween(sliceNames[0], sliceNames[1]), sliceNames[1:])
+		} else {
+			b.waitSpace(tokenAfter(sliceNames[0]), []string{})
+		}
 	}
 	if !lastSlash {
 		// If the last token read was not a slash,
@@ -677,6 +1019,21 @@ func (p *structSliceParameter) updateRecursive(
 				b.waitSpace(tokenAfter(name), []string{})
 			}
 		}
+		if p.max > 0 {
+			// Create states for reading the optional slice elements up to the maximum number.
+			for i := p.min; i < p.max; i++ {
+				name = p.name(i)
+				p.param.changeName(name)
+				update(b, p.param.allNames(paramNumber))
+				name = tokenAfter(name)
+				b.nextDelimiterRow(name).
+					onSlashError(invalidTokenMessage(name, scanner.Space, scanner.Slash)).
+					onSpace(b.nextState()).
+					onEnd()
+			}
+			b.waitEnd(p.tooManyMessage())
+			return
+		}
 		// Create additional states for reading an arbitrary number of slice elements after the required ones.
 		var loopState = b.nextState()
 		name = fmt.Sprintf("additional %s", p.parameterName)
@@ -707,48 +1064,176 @@ func (p *structSliceParameter) update(b *builder) {
 }
 
 // Creates a new structSliceParameter.
-func newStructSliceParameter(name string, min int, param *structParameter) *structSliceParameter {
+func newStructSliceParameter(name string, min, max int, param *structParameter) *structSliceParameter {
 	return &structSliceParameter{
 		sliceParameter: sliceParameter{
 			parameterName: parameterName(name),
 			min:           min,
+			max:           max,
 		},
 		param: param,
 	}
 }
 
-// Stores the state and the action to be performed when switching to this state.
+// A parameter that generates states for reading the optional float64 fields at the end of the structure
+// and the optional pointer to the structure following them.
+// The implementation assumes that the trailingParameter is the last parameter of the builder.
+type trailingParameter struct {
+	fields  []string          // The names of the optional fields.
+	group   []string          // The names of the fields of the structure the pointer points to.
+	setters []*trailingSetter // The setters of the values in the order of the line.
+}
+
+// Returns the name of the last of the specified number of values.
+func (p *trailingParameter) name(count int) string {
+	var fields, group = trailingLayout(count, len(p.fields), len(p.group))
+	if group > 0 {
+		return p.group[group-1]
+	}
+	return p.fields[fields-1]
+}
+
+// Implementation of the String method in the parameter interface.
+func (p *trailingParameter) String() string { return p.name(1) }
+
+// Returns the names of the fields of the group that are not read after the specified number of values.
+func (p *trailingParameter) unread(count int) []string {
+	var _, group = trailingLayout(count, len(p.fields), len(p.group))
+	if group == 0 {
+		return []string{}
+	}
+	return p.group[group:]
+}
+
+// Implementation of the update method in the parameter interface.
+func (p *trailingParameter) update(b *builder) {
+	var name string // The name of the current value.
+	for i, s := range p.setters {
+		name = p.name(i + 1)
+		newBaseParameter(name, s).baseUpdate(b.nextParameterRow(name, s.expected()), b.nextState(), p.unread(i))
+		if i != len(p.setters)-1 {
+			b.waitSpace(tokenAfter(name), p.unread(i+1))
+		}
+	}
+}
+
+// Creates a new trailingParameter of the optional float64 fields with the specified numbers and names
+// and the pointer field with the specified number pointing to the structure of the type t.
+func newTrailingParameter(fields []int, names []string, pointer int, t reflect.Type) *trailingParameter {
+	var p = &trailingParameter{
+		fields:  names,
+		group:   make([]string, t.NumField()),
+		setters: make([]*trailingSetter, len(fields)+t.NumField()),
+	}
+	for i := range p.group {
+		var field = t.Field(i)
+		p.group[i] = readName(&field)
+	}
+	for i := range p.setters {
+		p.setters[i] = newTrailingSetter(p.name(i+1), fields, pointer, len(p.group), i)
+	}
+	return p
+}
+
+// A parameter that generates states for reading the flag field followed by the field with the enum tag.
+// The line starts with the word of the flag tag followed by the enum value or only with the enum value.
+type flagParameter struct {
+	parameterName           // The name of the enum field.
+	flag          setter    // Reads the word of the flag tag or the enum value.
+	enum          setter    // Reads the enum value following the word of the flag tag.
+	check         *endCheck // Does not allow the line to end after the word of the flag tag.
+}
+
+// Implementation of the update method in the parameter interface.
+func (p *flagParameter) update(b *builder) {
+	var name = p.String()
+	newBaseParameter(name, p.flag).baseUpdate(b.nextParameterRow(name, scanner.Word), b.nextState(), b.getUnread())
+	// The line can end after the first word if it is the enum value, the check requires the value after the flag.
+	b.waitSpace(tokenAfter(name), nil)
+	b.builders[len(b.builders)-1].check = p.check
+	var row = b.nextParameterRow(name, scanner.Word)
+	newBaseParameter(name, p.enum).baseUpdate(row, b.nextState(), nil)
+	row.check = p.check
+}
+
+// Creates a new flagParameter of the flag field with the specified number and word,
+// the enum field following it is read by the specified setter.
+func newFlagParameter(name, word string, fieldNumber int, enum setter, elementType ElementType) *flagParameter {
+	var flag = condition{fieldNumber: fieldNumber}
+	return &flagParameter{
+		parameterName: parameterName(name),
+		flag:          newFlagSetter(word, fieldNumber, enum),
+		enum: newConditionalSetter(
+			flag,
+			enum,
+			errors.New(unexpectedTokenAfterDescribingElementMessage(elementType, scanner.Word)),
+		),
+		check: &endCheck{condition: flag, message: parametersNotSpecifiedMessage([]string{name})},
+	}
+}
+
+// A parameter of the optional field that is specified if and only if the condition on the field before it holds.
+type conditionalParameter struct {
+	*baseParameter           // Reads the value of the field if the condition holds.
+	check          *endCheck // Does not allow the line to end before the field if the condition holds.
+}
+
+// Implementation of the update method in the parameter interface.
+func (p *conditionalParameter) update(b *builder) {
+	// The line can end in the state following the previous value and in the state following the space after it.
+	b.builders[len(b.builders)-1].check = p.check
+	var row = b.nextParameterRow(p.String(), p.setter.expected())
+	p.baseUpdate(row, b.nextState(), b.getUnread())
+	row.check = p.check
+}
+
+// Creates a new conditionalParameter of the field with the specified name read by the setter.
+// words are the words of the with or without tag of the condition.
+func newConditionalParameter(name string, condition condition, words []string, setter setter) *conditionalParameter {
+	var err = fmt.Errorf("the %s parameter is only specified after %s", name, quoteWords(words))
+	if condition.negated {
+		err = fmt.Errorf("the %s parameter is not specified after %s", name, quoteWords(words))
+	}
+	return &conditionalParameter{
+		baseParameter: newBaseParameter(name, newConditionalSetter(condition, setter, err)),
+		check:         &endCheck{condition: condition, message: parametersNotSpecifiedMessage([]string{name})},
+	}
+}
+
+// Stores the state and the setter whose action is performed when switching to this state.
 type stateAction struct {
 	state  stateType
-	action action
+	setter setter
 }
 
 // Stores information about transitions from a single state.
 type rowBuilder struct {
 	stateActionRow [scanner.TokensCount]stateAction // A row of states and actions.
 	errorsRow      [scanner.TokensCount]string      // A row of error messages.
+	mode           scanner.Mode                     // The mode in which the scanner reads the token following the state.
+	check          *endCheck                        // The check performed when the end of the line is read in the state.
 }
 
 // Updates the row of states by transitioning through the token without an error.
-func (b *rowBuilder) onToken(t scanner.TokenType, s stateType, a action) *rowBuilder {
+func (b *rowBuilder) onToken(t scanner.TokenType, s stateType, a setter) *rowBuilder {
 	b.stateActionRow[t] = stateAction{
 		state:  s,
-		action: a,
+		setter: a,
 	}
 	b.errorsRow[t] = noErrorMessage
 	return b
 }
 
 // Updates the row of states by transitioning through the scanner.Word token without an error.
-func (b *rowBuilder) onWord(s stateType, a action) *rowBuilder { return b.onToken(scanner.Word, s, a) }
+func (b *rowBuilder) onWord(s stateType, a setter) *rowBuilder { return b.onToken(scanner.Word, s, a) }
 
 // Updates the row of states by transitioning through the scanner.Integer token without an error.
-func (b *rowBuilder) onInteger(s stateType, a action) *rowBuilder {
+func (b *rowBuilder) onInteger(s stateType, a setter) *rowBuilder {
 	return b.onToken(scanner.Integer, s, a)
 }
 
 // Updates the row of states by transitioning through the scanner.Float token without an error.
-func (b *rowBuilder) onFloat(s stateType, a action) *rowBuilder {
+func (b *rowBuilder) onFloat(s stateType, a setter) *rowBuilder {
 	return b.onToken(scanner.Float, s, a)
 }
 
@@ -767,7 +1252,7 @@ func (b *rowBuilder) onEnd() *rowBuilder {
 func (b *rowBuilder) onTokenError(t scanner.TokenType, message string) *rowBuilder {
 	b.stateActionRow[t] = stateAction{
 		state:  err,
-		action: nil,
+		setter: nil,
 	}
 	b.errorsRow[t] = message
 	return b
@@ -895,6 +1380,156 @@ func readMin(tags reflect.StructTag) int {
 	}
 }
 
+// Reads the max tag (maximum number of slice elements), 0 if the tag is not specified.
+func readMax(tags reflect.StructTag, min int) int {
+	if max, ok := tags.Lookup("max"); ok {
+		if res, err := strconv.ParseInt(max, 10, 8); err == nil {
+			if int(res) < min {
+				panic("the max tag cannot accept values less than the value of the min tag")
+			} else {
+				return int(res)
+			}
+		} else {
+			panic("error reading the max tag")
+		}
+	} else {
+		return 0
+	}
+}
+
+// Reads the count tag (exact number of slice elements) or the min and max tags if it is not specified.
+func readBounds(tags reflect.StructTag) (int, int) {
+	if count, ok := tags.Lookup("count"); ok {
+		if _, ok = tags.Lookup("min"); ok {
+			panic("the min tag cannot be set for a slice with the count tag")
+		}
+		if _, ok = tags.Lookup("max"); ok {
+			panic("the max tag cannot be set for a slice with the count tag")
+		}
+		if res, err := strconv.ParseInt(count, 10, 8); err == nil {
+			if res < 1 {
+				panic("the count tag cannot accept values less than one")
+			} else {
+				return int(res), int(res)
+			}
+		} else {
+			panic("error reading the count tag")
+		}
+	} else {
+		var min = readMin(tags)
+		return min, readMax(tags, min)
+	}
+}
+
+// Returns true if the string is read by the scanner as a single scanner.Word token.
+func isWord(s string) bool {
+	var sc = scanner.NewScanner(strings.NewReader(s))
+	var tokenType, _ = sc.Next()
+	var next, _ = sc.Next()
+	return tokenType == scanner.Word && next == scanner.EOF
+}
+
+// Reads the enum tag (the words converted to their numbers), nil if the tag is not specified.
+func readEnum(tags reflect.StructTag) []string {
+	if enum, ok := tags.Lookup("enum"); ok {
+		var words = strings.Split(enum, ",")
+		for _, word := range words {
+			if !isWord(word) {
+				panic("the enum tag must contain words separated by commas")
+			}
+		}
+		return words
+	} else {
+		return nil
+	}
+}
+
+// Reads the union tag (the words converted to the specified integers), nil if the tag is not specified.
+func readUnion(tags reflect.StructTag) ([]string, map[string]int) {
+	if union, ok := tags.Lookup("union"); ok {
+		var (
+			pairs  = strings.Split(union, ",")
+			words  = make([]string, len(pairs))
+			values = make(map[string]int, len(pairs))
+		)
+		for i, pair := range pairs {
+			var word, value, found = strings.Cut(pair, "=")
+			var number, err = strconv.Atoi(value)
+			if !found || err != nil || !isWord(word) {
+				panic("the union tag must contain pairs of a word and an integer separated by commas: word=integer")
+			}
+			words[i], values[word] = word, number
+		}
+		return words, values
+	} else {
+		return nil, nil
+	}
+}
+
+// Reads the text tag (whether the parameter is the rest of the line).
+func readText(tags reflect.StructTag) bool {
+	if text, ok := tags.Lookup("text"); ok {
+		if res, err := strconv.ParseBool(text); err == nil {
+			return res
+		} else {
+			panic("the text tag must take the values 'true' or 'false'")
+		}
+	} else {
+		return false
+	}
+}
+
+// Reads the flag tag (the word that sets the bool field).
+func readFlag(tags reflect.StructTag) string {
+	if flag, ok := tags.Lookup("flag"); ok {
+		if !isWord(flag) {
+			panic("the flag tag must contain a word")
+		}
+		return flag
+	} else {
+		panic("the bool field must have the flag tag specified")
+	}
+}
+
+// Returns the values of the words of the enum tag: the numbers of the words.
+func enumValues(words []string) map[string]int {
+	var values = make(map[string]int, len(words))
+	for i, word := range words {
+		values[word] = i
+	}
+	return values
+}
+
+// Reads the with or without tag (the words of the enum or union field with or without which the field is specified).
+// Returns the words and the condition on the field with the specified number and values of the words,
+// nil if neither tag is specified.
+func readCondition(tags reflect.StructTag, fieldNumber int, values map[string]int) ([]string, *condition) {
+	var (
+		with, hasWith       = tags.Lookup("with")
+		without, hasWithout = tags.Lookup("without")
+		c                   = &condition{fieldNumber: fieldNumber, negated: hasWithout}
+	)
+	switch {
+	case hasWith && hasWithout:
+		panic("the with and without tags cannot be set for the same field")
+	case !hasWith && !hasWithout:
+		return nil, nil
+	case values == nil:
+		panic("the field with the with or without tag must follow the field with the enum or union tag")
+	case hasWithout:
+		with = without
+	}
+	var words = strings.Split(with, ",")
+	for _, word := range words {
+		var value, ok = values[word]
+		if !ok {
+			panic("the with and without tags must contain the words of the enum or union tag separated by commas")
+		}
+		c.values = append(c.values, value)
+	}
+	return words, c
+}
+
 // Panics if the optional tag is present among the tags.
 func requireNoOptional(tags reflect.StructTag, typeName string) {
 	if _, ok := tags.Lookup("optional"); ok {
@@ -916,6 +1551,50 @@ func requireNoMin(tags reflect.StructTag, typeName string) {
 	}
 }
 
+// Panics if the text tag is present among the tags.
+func requireNoText(tags reflect.StructTag, typeName string) {
+	if _, ok := tags.Lookup("text"); ok {
+		panic(fmt.Sprintf("the text tag cannot be set for a %s field", typeName))
+	}
+}
+
+// Panics if the enum or union tag is present among the tags.
+func requireNoWords(tags reflect.StructTag, typeName string) {
+	if _, ok := tags.Lookup("enum"); ok {
+		panic(fmt.Sprintf("the enum tag cannot be set for a %s field", typeName))
+	}
+	if _, ok := tags.Lookup("union"); ok {
+		panic(fmt.Sprintf("the union tag cannot be set for a %s field", typeName))
+	}
+}
+
+// Panics if the max or count tag is present among the tags.
+func requireNoBounds(tags reflect.StructTag, typeName string) {
+	if _, ok := tags.Lookup("max"); ok {
+		panic(fmt.Sprintf("the max tag cannot be set for a %s field", typeName))
+	}
+	if _, ok := tags.Lookup("count"); ok {
+		panic(fmt.Sprintf("the count tag cannot be set for a %s field", typeName))
+	}
+}
+
+// Panics if the flag tag is present among the tags.
+func requireNoFlag(tags reflect.StructTag, typeName string) {
+	if _, ok := tags.Lookup("flag"); ok {
+		panic(fmt.Sprintf("the flag tag cannot be set for a %s field", typeName))
+	}
+}
+
+// Panics if the with or without tag is present among the tags.
+func requireNoCondition(tags reflect.StructTag, typeName string) {
+	if _, ok := tags.Lookup("with"); ok {
+		panic(fmt.Sprintf("the with tag cannot be set for a %s field", typeName))
+	}
+	if _, ok := tags.Lookup("without"); ok {
+		panic(fmt.Sprintf("the without tag cannot be set for a %s field", typeName))
+	}
+}
+
 // Panics if wasOptional is true.
 // It is necessary for all optional fields to be the last in the structure.
 func requireWasNotOptional(wasOptional bool) {
@@ -946,6 +1625,8 @@ func createNestedStructParameter(
 		field = t.Field(i)
 		nestedName = readName(&field)
 		tags = field.Tag
+		requireNoFlag(tags, "nested struct")
+		requireNoCondition(tags, "nested struct")
 		switch delimiter {
 		case scanner.Space:
 			requireNoOptional(tags, "struct with space delimiter")
@@ -960,10 +1641,16 @@ func createNestedStructParameter(
 		case reflect.Int:
 			requireNoDelimiter(tags, "int")
 			requireNoMin(tags, "int")
+			requireNoText(tags, "int")
+			requireNoWords(tags, "nested int")
+			requireNoBounds(tags, "int")
 			param = newBaseParameter(nestedName, wrapper(i, newIntSetter(nestedName)))
 		case reflect.Float64:
 			requireNoDelimiter(tags, "float64")
 			requireNoMin(tags, "float64")
+			requireNoText(tags, "float64")
+			requireNoWords(tags, "float64")
+			requireNoBounds(tags, "float64")
 			param = newBaseParameter(nestedName, wrapper(i, newFloatSetter(nestedName)))
 		default:
 			panic(fmt.Sprintf("unsupported nested struct field type: %s", field.Type.Kind()))
@@ -988,61 +1675,160 @@ func (b *builder) createStructParameters() {
 		typeName    string
 		optional    bool
 		hasOptional = false
-		min         int
+		min, max    int
 		param       parameter
+		// The numbers and the names of the optional float64 fields, which can be followed by an optional pointer.
+		optionalFields []int
+		optionalNames  []string
+		// The number of the last field with the enum or union tag and the values of its words,
+		// which the with and without tags refer to.
+		wordsField = -1
+		wordValues map[string]int
 	)
-	if t.NumField() < 1 {
-		panic("the parser cannot be built on a structure without fields")
-	}
 	// Creating parameters for each field of the structure.
 	for i := 0; i < t.NumField(); i++ {
 		field = t.Field(i)
 		name = readName(&field)
 		tags = field.Tag
+		if field.Type.Kind() != reflect.Bool {
+			requireNoFlag(tags, field.Type.Kind().String())
+		}
+		if field.Type.Kind() != reflect.Float64 {
+			requireNoCondition(tags, field.Type.Kind().String())
+		}
 		switch field.Type.Kind() {
+		case reflect.Bool:
+			typeName = "bool"
+			requireNoOptional(tags, typeName)
+			requireNoDelimiter(tags, typeName)
+			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoWords(tags, typeName)
+			requireNoBounds(tags, typeName)
+			var word = readFlag(tags)
+			if i != 0 || t.NumField() != 2 {
+				panic("the bool field must be the first of the two fields of the structure")
+			}
+			var (
+				next     = t.Field(1)
+				nextName = readName(&next)
+				words    = readEnum(next.Tag)
+			)
+			if words == nil || next.Type.Kind() != reflect.Uint8 && next.Type.Kind() != reflect.Int {
+				panic("the bool field must be followed by the field with the enum tag")
+			}
+			requireNoOptional(next.Tag, "enum following the bool")
+			for _, w := range words {
+				if w == word {
+					panic("the word of the flag tag cannot be one of the words of the enum tag")
+				}
+			}
+			param = newFlagParameter(nextName, word, i, newStructSetter(1, newEnumSetter(nextName, words)), b.valueType)
+			// The enum field is read by the same parameter.
+			i++
 		case reflect.Uint8:
-			if typeName != "DirectionType" {
-				panic("the field with the base type uint8 must have the type DirectionType")
+			typeName = "uint8"
+			requireNoDelimiter(tags, typeName)
+			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoBounds(tags, typeName)
+			if _, ok := tags.Lookup("union"); ok {
+				panic("the union tag cannot be set for a uint8 field")
+			}
+			if words := readEnum(tags); words != nil {
+				optional = readOptional(tags, i == 0)
+				if !optional {
+					requireWasNotOptional(hasOptional)
+				}
+				hasOptional = optional
+				wordsField, wordValues = i, enumValues(words)
+				param = newBaseParameter(name, newStructSetter(i, newEnumSetter(name, words)))
+				break
+			}
+			typeName = "DirectionType"
+			if field.Type != reflect.TypeOf(types.DirectionType(0)) {
+				panic("the field with the base type uint8 without the enum tag must have the type DirectionType")
 			}
 			if i != 0 {
 				panic("the DirectionType field must be the first in the structure")
 			}
 			requireNoOptional(tags, typeName)
-			requireNoDelimiter(tags, typeName)
-			requireNoMin(tags, typeName)
 			param = newBaseParameter(name, newStructSetter(i, newDirectionTypeSetter(name)))
 		case reflect.Int:
 			typeName = "int"
 			requireNoDelimiter(tags, typeName)
 			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoBounds(tags, typeName)
 			optional = readOptional(tags, i == 0)
 			if !optional {
 				requireWasNotOptional(hasOptional)
 			}
 			hasOptional = optional
-			param = newBaseParameter(name, newStructSetter(i, newIntSetter(name)))
+			var (
+				enum          = readEnum(tags)
+				words, values = readUnion(tags)
+			)
+			switch {
+			case enum != nil && words != nil:
+				panic("the enum and union tags cannot be set for the same field")
+			case enum != nil:
+				wordsField, wordValues = i, enumValues(enum)
+				param = newBaseParameter(name, newStructSetter(i, newEnumSetter(name, enum)))
+			case words != nil:
+				wordsField, wordValues = i, values
+				param = newBaseParameter(name, newStructSetter(i, newUnionSetter(name, words, values)))
+			default:
+				param = newBaseParameter(name, newStructSetter(i, newIntSetter(name)))
+			}
 		case reflect.Float64:
 			typeName = "float64"
 			requireNoDelimiter(tags, typeName)
 			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoWords(tags, typeName)
+			requireNoBounds(tags, typeName)
 			optional = readOptional(tags, i == 0)
 			if !optional {
 				requireWasNotOptional(hasOptional)
 			}
 			hasOptional = optional
+			if words, c := readCondition(tags, wordsField, wordValues); c != nil {
+				if !optional {
+					panic("the with and without tags can only be set for an optional field")
+				}
+				param = newConditionalParameter(name, *c, words, newStructSetter(i, newFloatSetter(name)))
+				break
+			}
+			if optional {
+				optionalFields = append(optionalFields, i)
+				optionalNames = append(optionalNames, name)
+			}
 			param = newBaseParameter(name, newStructSetter(i, newFloatSetter(name)))
 		case reflect.String:
 			typeName = "string"
 			requireNoOptional(tags, typeName)
 			requireNoDelimiter(tags, typeName)
 			requireNoMin(tags, typeName)
+			requireNoWords(tags, typeName)
+			requireNoBounds(tags, typeName)
 			requireWasNotOptional(hasOptional)
-			param = newBaseParameter(name, newStringSetter())
+			if readText(tags) {
+				if i != t.NumField()-1 {
+					panic("the text field must be the last field of the structure")
+				}
+				param = newBaseParameter(name, newStructSetter(i, newStringSetter(scanner.Text)))
+			} else {
+				param = newBaseParameter(name, newStructSetter(i, newStringSetter(scanner.Names)))
+			}
 		case reflect.Struct:
 			typeName = "nested struct"
 			requireNoOptional(tags, typeName)
 			requireNoDelimiter(tags, typeName)
 			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoWords(tags, typeName)
+			requireNoBounds(tags, typeName)
 			requireWasNotOptional(hasOptional)
 			param = createNestedStructParameter(
 				name,
@@ -1052,6 +1838,41 @@ func (b *builder) createStructParameters() {
 					return newStructSetter(i, newStructSetter(fieldNumber, setter))
 				},
 			)
+		case reflect.Ptr:
+			typeName = "pointer"
+			requireNoDelimiter(tags, typeName)
+			requireNoMin(tags, typeName)
+			requireNoText(tags, typeName)
+			requireNoWords(tags, typeName)
+			requireNoBounds(tags, typeName)
+			if i != t.NumField()-1 {
+				panic("the pointer must be the last field of the structure")
+			}
+			if !readOptional(tags, i == 0) {
+				panic("the pointer field must be optional")
+			}
+			if field.Type.Elem().Kind() != reflect.Struct || field.Type.Elem().NumField() < 1 {
+				panic("the pointer field must point to a structure with fields")
+			}
+			for j := 0; j < field.Type.Elem().NumField(); j++ {
+				var nested = field.Type.Elem().Field(j)
+				if nested.Type.Kind() != reflect.Float64 {
+					panic(fmt.Sprintf("unsupported pointed struct field type: %s", nested.Type.Kind()))
+				}
+				requireNoOptional(nested.Tag, "pointed struct")
+			}
+			// The optional fields preceding the pointer are read by the same parameter,
+			// since the places of their values depend on the number of the values.
+			if len(b.params)-len(b.paramNames) != len(optionalFields) {
+				panic("only the optional float64 fields can precede the pointer field")
+			}
+			// Otherwise the values of the optional fields could be read as the values of the structure.
+			if len(optionalFields) >= field.Type.Elem().NumField() {
+				panic("the pointed structure must have more fields than the optional fields preceding the pointer")
+			}
+			b.params = b.params[:len(b.params)-len(optionalFields)]
+			hasOptional = true
+			param = newTrailingParameter(optionalFields, optionalNames, i, field.Type.Elem())
 		case reflect.Slice:
 			if i != t.NumField()-1 {
 				panic("the slice must be the last field of the structure")
@@ -1059,32 +1880,37 @@ func (b *builder) createStructParameters() {
 			// The parameters of the slices themselves fill in the last states of the finite state machine.
 			b.needFinalize = false
 			requireNoOptional(tags, "slice")
+			requireNoText(tags, "slice")
+			requireNoWords(tags, "slice")
 			requireWasNotOptional(hasOptional)
-			min = readMin(tags)
+			min, max = readBounds(tags)
 			switch field.Type.Elem().Kind() {
 			case reflect.Int:
 				requireNoDelimiter(tags, "[]int")
 				param = newBaseSliceParameter(
 					name,
 					min,
-					newBaseParameter("", newSliceAppender(newSliceSetter(newIntSetter("")))),
+					max,
+					newBaseParameter(name, newStructSetter(i, newSliceAppender(newSliceSetter(newIntSetter(name))))),
 				)
 			case reflect.Float64:
 				requireNoDelimiter(tags, "[]float64")
 				param = newBaseSliceParameter(
 					name,
 					min,
-					newBaseParameter("", newSliceAppender(newSliceSetter(newFloatSetter("")))),
+					max,
+					newBaseParameter(name, newStructSetter(i, newSliceAppender(newSliceSetter(newFloatSetter(name))))),
 				)
 			case reflect.String:
 				requireNoDelimiter(tags, "[]string")
 				param = newBaseSliceParameter(
 					name,
 					min,
-					newBaseParameter(name, newSliceAppender(newSliceSetter(newStringSetter()))),
+					max,
+					newBaseParameter(name, newStructSetter(i, newSliceAppender(newSliceSetter(newStringSetter(scanner.Names))))),
 				)
 			case reflect.Struct:
-				param = newStructSliceParameter(name, min, createNestedStructParameter(
+				param = newStructSliceParameter(name, min, max, createNestedStructParameter(
 					name,
 					readDelimiter(tags),
 					field.Type.Elem(),
@@ -1173,6 +1999,20 @@ func (b *builder) waitSlash(name string, unread []string) {
 	}
 }
 
+// Creates and fills in the state following the space after the last possible parameter,
+// in which only the end of the line can be read. The message is returned for any other token.
+func (b *builder) waitEnd(message string) {
+	b.nextEmptyRow().
+		onWordError(message).
+		onIntegerError(message).
+		onFloatError(message).
+		onSlashError(message).
+		onSpaceError(message).
+		onEnd().
+		onUnknownError(message).
+		onCommentError(message)
+}
+
 // Initializes the builder by processing the start state and err state.
 func (b *builder) initialize() {
 	b.nextEmptyRow().
@@ -1207,6 +2047,12 @@ func (b *builder) finalize() {
 		onEnd().
 		onUnknownError(impossibleTokenAfterDescribingElementMessage(b.valueType, scanner.Unknown)).
 		onCommentError(impossibleTokenAfterDescribingElementMessage(b.valueType, scanner.Unknown))
+	b.waitLineEnd()
+}
+
+// Creates and fills in the state following the space after the element description,
+// in which only the end of the line can be read.
+func (b *builder) waitLineEnd() {
 	b.nextEmptyRow().
 		onWordError(unexpectedTokenAfterDescribingElementMessage(b.valueType, scanner.Word)).
 		onIntegerError(unexpectedTokenAfterDescribingElementMessage(b.valueType, scanner.Integer)).
@@ -1240,15 +2086,20 @@ func (b *builder) buildMachine() *finiteStateMachine {
 	for i, rb := range b.builders {
 		for j, sa := range rb.stateActionRow {
 			matrixRow[j] = sa.state
-			if m.actions[sa.state] == nil {
-				m.actions[sa.state] = sa.action
-			} else if sa.action != nil {
+			if sa.setter == nil {
+				continue
+			}
+			if m.actions[sa.state] != nil {
 				// The action performed during the transition to the state must be defined unambiguously.
 				panic(fmt.Sprintf("two actions are specified when transitioning to the same state: %d", sa.state))
 			}
+			m.actions[sa.state] = sa.setter.set
+			m.setters[sa.state] = sa.setter
 		}
 		m.matrix[i] = matrixRow
 		m.errors[i] = rb.errorsRow
+		m.modes[i] = rb.mode
+		m.checks[i] = rb.check
 	}
 	// Filling the remaining states with actions that do nothing.
 	for i := 0; i < len(m.actions); i++ {
@@ -1271,7 +2122,12 @@ func (b *builder) build() *finiteStateMachine {
 			b.waitSpace(delimiterBetween(param.String(), b.params[b.position].String()), b.getUnread())
 		}
 	}
-	if b.needFinalize {
+	switch {
+	case len(b.params) == 0:
+		// The element without parameters is read from the name followed by the end of the line.
+		b.builders[start].onEnd()
+		b.waitLineEnd()
+	case b.needFinalize:
 		b.finalize()
 	}
 	return b.buildMachine()
@@ -1307,10 +2163,14 @@ func newBuilder(elementType ElementType, t reflect.Type) *builder {
 // The following limitations apply to the structure:
 // 	* The structure fields are extracted from the line in the order in which they are specified in the structure.
 // 	* Only public fields will be parsed.
-// 	* Structure fields must have one of the following basic types: uint8, int, float64, string, struct, []int, []float64, []string, []struct.
-// 	* If a field is of the slice type, it must be the last one in the structure.
+// 	* Structure fields must have one of the following basic types: bool, uint8, int, float64, string, struct, *struct, []int, []float64, []string, []struct.
+// 	* A structure without fields is read from a line without parameters.
+// 	* If a field is of the bool type, it must be the first of the two fields of the structure and have the flag tag,
+// 	the second field must have the enum tag.
+// 	* If a field is of the slice or *struct type, it must be the last one in the structure.
 // 	* If a field is of the struct or []struct type, its fields must be of the base type int or float64.
-// 	* If a field is of the uint8 base type, it must be of the type DirectionType.
+// 	* If a field is of the *struct type, its fields must be of the base type float64.
+// 	* If a field is of the uint8 base type, it must be of the type DirectionType or have the enum tag.
 //
 // To specify additional information about the fields, use the following tags:
 //
@@ -1325,9 +2185,14 @@ func newBuilder(elementType ElementType, t reflect.Type) *builder {
 //	Used to specify optional fields.
 //	Optional fields must be the last fields of the structure.
 // 	All fields in the structure cannot be optional.
-// 	This tag can only be specified for fields of type int and float64.
+// 	This tag can only be specified for fields of type int, float64 and *struct and for the fields with the enum tag.
 // 	If the tag value is not specified, the field is processed as required (like optional="false").
 //	These rules also apply to nested structures (fields of the struct type).
+//	The field of the *struct type must be optional, its fields are read all or none, and the pointer is nil if they are not specified.
+//	It can be preceded only by the optional fields of the float64 type, which must be fewer than the fields of the structure.
+//	The fields of the structure take the last values of the line as soon as there are enough of them,
+//	the optional fields take the values before them.
+//	For example, the vertex is read as x y z w or x y z r g b or x y z w r g b.
 //
 // 	delimiter
 //
@@ -1341,8 +2206,55 @@ func newBuilder(elementType ElementType, t reflect.Type) *builder {
 // 	min
 //
 // 	It can only accept integer values that are greater than zero.
-// 	This tag must be specified for slices and cannot be specified for other types.
+// 	This tag must be specified for slices without the count tag and cannot be specified for other types.
 // 	Used to specify the minimum number of slice elements.
+//
+// 	max
+//
+// 	It can only accept integer values that are not less than the value of the min tag.
+// 	This tag can only be specified for slices.
+// 	Used to specify the maximum number of slice elements, the number of elements is not limited by default.
+//
+// 	count
+//
+// 	It can only accept integer values that are greater than zero.
+// 	This tag can only be specified for slices instead of the min and max tags.
+// 	Used to specify the exact number of slice elements.
+//
+// 	enum
+//
+// 	It takes the words separated by commas, such as enum:"cparm,cspace,curv".
+// 	This tag can only be specified for fields of the uint8 and int base types, except for the fields of nested structures.
+// 	The field is read as one of the words and takes the number of the word in the tag starting from zero.
+//
+// 	union
+//
+// 	It takes the pairs of a word and an integer separated by commas, such as union:"off=0".
+// 	This tag can only be specified for fields of type int, except for the fields of nested structures.
+// 	The field is read as an integer or as one of the words, which takes the integer specified for it.
+//
+// 	flag
+//
+// 	It takes a word that is not one of the words of the enum tag of the next field, such as flag:"rat".
+// 	This tag must be specified for the bool field and cannot be specified for other types.
+// 	The field is true if the line starts with the word, which must be followed by the value of the next field,
+// 	otherwise the line starts with the value of the next field.
+//
+// 	with, without
+//
+// 	They take the words of the last field with the enum or union tag preceding the field separated by commas,
+// 	such as with:"curv" or without:"off".
+// 	These tags can only be specified for optional fields of type float64.
+// 	The field is specified if and only if the preceding field takes one of the words (with)
+// 	or does not take any of them (without).
+//
+// 	text
+//
+//	It can take the values 'true' or 'false'.
+// 	This tag can only be specified for fields of type string.
+// 	By default, a string field is read as a name: any sequence of characters except spaces, such as a file name.
+// 	If the tag value is 'true', the field is read as the rest of the line up to a comment, so it can contain spaces.
+// 	Such a field must be the last field of the structure.
 func buildParser(elementType ElementType, element interface{}) elementParser {
 	var t = reflect.TypeOf(element)
 	if t.Kind() != reflect.Ptr {
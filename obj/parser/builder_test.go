@@ -3,6 +3,8 @@ package parser
 import (
 	"computer_graphics/obj/parser/types"
 	"computer_graphics/obj/scanner"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -37,6 +39,19 @@ func testParser(parser elementParser, want [][scanner.TokensCount]stateType, t *
 	}
 }
 
+// Testing the modes in which the scanner reads the tokens following the states of an arbitrary elementParser.
+func testModes(parser elementParser, want []scanner.Mode, t *testing.T) {
+	var got = parser.(*finiteStateMachine).modes
+	if len(got) != len(want) {
+		t.Fatalf("Incorrect number of the modes, got: %d, want: %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Invalid mode of the state %d, got: %d, want: %d", i, got[i], want[i])
+		}
+	}
+}
+
 // Testing the vertex elementParser.
 func TestBuildParser_vertex(t *testing.T) {
 	var (
@@ -52,6 +67,12 @@ func TestBuildParser_vertex(t *testing.T) {
 			{1, 1, 1, 1, 8, 0, 0, 1, 1},
 			{1, 9, 9, 1, 1, 0, 0, 1, 1},
 			{1, 1, 1, 1, 10, 0, 0, 1, 1},
+			{1, 11, 11, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 12, 1, 1, 1, 1},
+			{1, 13, 13, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 14, 0, 0, 1, 1},
+			{1, 15, 15, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 16, 0, 0, 1, 1},
 			{1, 1, 1, 1, 1, 0, 0, 1, 1},
 		}
 	)
@@ -128,3 +149,414 @@ func TestBuildParser_face(t *testing.T) {
 	)
 	testParser(parser, want, t)
 }
+
+// Testing the vertex texture elementParser.
+func TestBuildParser_vertexTexture(t *testing.T) {
+	var (
+		parser = buildParser(VertexTexture, types.NewVertexTexture())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 3, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{1, 5, 5, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 7, 7, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 8, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the vertex normal elementParser.
+func TestBuildParser_vertexNormal(t *testing.T) {
+	var (
+		parser = buildParser(VertexNormal, types.NewVertexNormal())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 3, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 1, 1, 1, 1},
+			{1, 5, 5, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 1, 1, 1, 1},
+			{1, 7, 7, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 8, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the basis matrix elementParser.
+func TestBuildParser_basisMatrix(t *testing.T) {
+	var (
+		parser = buildParser(BasisMatrix, types.NewBasisMatrix())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 1, 1, 1, 1},
+			{1, 5, 5, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 7, 7, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the trim elementParser.
+func TestBuildParser_trim(t *testing.T) {
+	var (
+		parser = buildParser(Trim, types.NewTrim())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 3, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 1, 1, 1, 1},
+			{1, 5, 5, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 1, 1, 1, 1},
+			{1, 7, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 8, 0, 0, 1, 1},
+			{1, 9, 9, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 10, 1, 1, 1, 1},
+			{1, 11, 11, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 12, 1, 1, 1, 1},
+			{1, 13, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 8, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the group elementParser.
+func TestBuildParser_group(t *testing.T) {
+	var (
+		parser = buildParser(Group, types.NewGroup())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{5, 1, 1, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	testModes(parser, []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Names, scanner.Tokens}, t)
+}
+
+// Testing the object elementParser.
+func TestBuildParser_object(t *testing.T) {
+	var (
+		parser = buildParser(Object, types.NewObject())
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	testModes(parser, []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Text, scanner.Tokens, scanner.Tokens}, t)
+}
+
+// Reads the parameters of the line by the elementParser as if the name of the statement had already been read.
+// Returns the read element and the messages of the reported diagnostics.
+func readParameters(p elementParser, line string) (interface{}, []string) {
+	var (
+		messages []string
+		ps       = &parser{scanner: scanner.NewScanner(strings.NewReader(line + "\n"))}
+	)
+	ps.handler = func(d Diagnostic) { messages = append(messages, d.Message) }
+	var _, element, _ = ps.readElement(UnknownElement, p)
+	return element, messages
+}
+
+// A curve approximation technique with a single argument, the technique is read by the enum tag.
+type approximation struct {
+	Technique uint8   `name:"technique" enum:"cparm,cspace,curv"`
+	Value     float64 `name:"value"`
+}
+
+// Testing the elementParser of an enum parameter.
+func TestBuildParser_enum(t *testing.T) {
+	var (
+		parser = buildParser(CurveApproximation, &approximation{})
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 1, 1, 1, 1},
+			{1, 5, 5, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	if element, _ := readParameters(parser, " cspace 0.5"); fmt.Sprint(element) != "&{1 0.5}" {
+		t.Errorf("Invalid element, got: %v, want: &{1 0.5}", element)
+	}
+	var _, messages = readParameters(parser, " curvature 0.5")
+	if want := "the technique parameter must take the values 'cparm', 'cspace' or 'curv'"; fmt.Sprint(messages) != "["+want+"]" {
+		t.Errorf("Invalid messages, got: %v, want: [%s]", messages, want)
+	}
+}
+
+// Testing the elementParser of a parameter that is an integer or a word.
+func TestBuildParser_union(t *testing.T) {
+	var (
+		parser = buildParser(SmoothingGroup, &struct {
+			Number int `name:"group number" union:"off=0,on=1"`
+		}{})
+		want = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 3, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	for line, want := range map[string]string{" off": "&{0}", " on": "&{1}", " 7": "&{7}"} {
+		if element, _ := readParameters(parser, line); fmt.Sprint(element) != want {
+			t.Errorf("Invalid element of the line %q, got: %v, want: %s", line, element, want)
+		}
+	}
+	var _, messages = readParameters(parser, " of")
+	if want := "the group number parameter must be an integer or take the values 'off' or 'on'"; fmt.Sprint(messages) != "["+want+"]" {
+		t.Errorf("Invalid messages, got: %v, want: [%s]", messages, want)
+	}
+}
+
+// A curve or surface type with the rational form set by a word preceding the type.
+type flagged struct {
+	Rational bool  `name:"rational form" flag:"rat"`
+	Type     uint8 `name:"type" enum:"bezier,bspline"`
+}
+
+// Testing the elementParser of a flag followed by an enum parameter.
+func TestBuildParser_flag(t *testing.T) {
+	var (
+		parser = buildParser(CurveSurfaceType, &flagged{})
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{5, 1, 1, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	for line, want := range map[string]string{" bspline": "&{false 1}", " rat bspline": "&{true 1}"} {
+		if element, _ := readParameters(parser, line); fmt.Sprint(element) != want {
+			t.Errorf("Invalid element of the line %q, got: %v, want: %s", line, element, want)
+		}
+	}
+	for line, want := range map[string]string{
+		" rat":            "parameter type is not specified",
+		" rat ":           "parameter type is not specified",
+		" bezier bspline": "unexpected token received after describing a curve surface type - WORD",
+	} {
+		if _, messages := readParameters(parser, line); fmt.Sprint(messages) != "["+want+"]" {
+			t.Errorf("Invalid messages of the line %q, got: %v, want: [%s]", line, messages, want)
+		}
+	}
+}
+
+// A merging group whose resolution is specified only if the group is turned on.
+type conditional struct {
+	Number     int     `name:"group number" union:"off=0"`
+	Resolution float64 `name:"resolution" optional:"true" without:"off"`
+}
+
+// Testing the elementParser of an optional parameter that depends on the value of the previous parameter.
+func TestBuildParser_condition(t *testing.T) {
+	var (
+		parser = buildParser(MergingGroup, &conditional{})
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{3, 3, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{1, 5, 5, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	for line, want := range map[string]string{" off": "&{0 0}", " 2 0.5": "&{2 0.5}"} {
+		if element, _ := readParameters(parser, line); fmt.Sprint(element) != want {
+			t.Errorf("Invalid element of the line %q, got: %v, want: %s", line, element, want)
+		}
+	}
+	for line, want := range map[string]string{
+		" 2":       "parameter resolution is not specified",
+		" 2 ":      "parameter resolution is not specified",
+		" off 0.5": "the resolution parameter is not specified after 'off'",
+	} {
+		if _, messages := readParameters(parser, line); fmt.Sprint(messages) != "["+want+"]" {
+			t.Errorf("Invalid messages of the line %q, got: %v, want: [%s]", line, messages, want)
+		}
+	}
+}
+
+// Testing the elementParser of an element without parameters.
+func TestBuildParser_empty(t *testing.T) {
+	var (
+		parser = buildParser(End, &struct{}{})
+		want   = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	var _, messages = readParameters(parser, " 1")
+	if want := "unexpected token received after describing a end - INTEGER"; fmt.Sprint(messages) != "["+want+"]" {
+		t.Errorf("Invalid messages, got: %v, want: [%s]", messages, want)
+	}
+}
+
+// Testing the elementParser of a slice with the maximum number of elements.
+func TestBuildParser_max(t *testing.T) {
+	var (
+		parser = buildParser(BasisMatrix, &struct {
+			Values []float64 `name:"value" min:"1" max:"3"`
+		}{})
+		want = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 3, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 0, 0, 1, 1},
+			{1, 5, 5, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 7, 7, 1, 1, 0, 0, 1, 1},
+			{1, 1, 1, 1, 8, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+	var _, messages = readParameters(parser, " 1 2 3 4")
+	if want := "the number of the value parameters cannot exceed 3"; fmt.Sprint(messages) != "["+want+"]" {
+		t.Errorf("Invalid messages, got: %v, want: [%s]", messages, want)
+	}
+}
+
+// Testing the elementParser of a slice with a fixed number of elements.
+func TestBuildParser_count(t *testing.T) {
+	var (
+		parser = buildParser(Parameter, &struct {
+			Values []float64 `name:"value" count:"2"`
+		}{})
+		want = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 3, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 4, 1, 1, 1, 1},
+			{1, 5, 5, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the elementParser of a slice of structures with the maximum number of elements.
+// The elements are written in the same format: all with the optional parameter or all without it.
+func TestBuildParser_structMax(t *testing.T) {
+	var (
+		parser = buildParser(Line, &struct {
+			Vertices []struct {
+				Vertex  int `name:"vertex index"`
+				Texture int `name:"texture vertex index" optional:"true"`
+			} `name:"vertices" delimiter:"slash" min:"1" max:"2"`
+		}{})
+		want = [][scanner.TokensCount]stateType{
+			{1, 1, 1, 1, 2, 1, 1, 1, 1},
+			{1, 1, 1, 1, 1, 1, 1, 1, 1},
+			{1, 3, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 4, 11, 0, 0, 1, 1},
+			{1, 5, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 6, 0, 0, 1, 1},
+			{1, 7, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 8, 1, 1, 1, 1, 1},
+			{1, 9, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 10, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+			{1, 12, 1, 1, 1, 1, 1, 1, 1},
+			{1, 1, 1, 1, 13, 0, 0, 1, 1},
+			{1, 1, 1, 1, 1, 0, 0, 1, 1},
+		}
+	)
+	testParser(parser, want, t)
+}
+
+// Testing the elementParser of the optional fields followed by the optional pointer to a structure.
+// The values are read depending on their number, see the types.Vertex.
+func TestBuildParser_pointer(t *testing.T) {
+	var parser = buildParser(Vertex, types.NewVertex())
+	for line, want := range map[string]string{
+		" 1 2 3":              "&{1 2 3 0 <nil>}",
+		" 1 2 3 4":            "&{1 2 3 4 <nil>}",
+		" 1 2 3 0.5 0.25 1":   "&{1 2 3 0 {0.5 0.25 1}}",
+		" 1 2 3 4 0.5 0.25 1": "&{1 2 3 4 {0.5 0.25 1}}",
+	} {
+		if element, messages := readParameters(parser, line); fmt.Sprint(element) != want || len(messages) > 0 {
+			t.Errorf("Invalid element of the line %q, got: %v %v, want: %s", line, element, messages, want)
+		}
+	}
+	var _, messages = readParameters(parser, " 1 2 3 4 5")
+	if want := "parameter blue component is not specified"; fmt.Sprint(messages) != "["+want+"]" {
+		t.Errorf("Invalid messages, got: %v, want: [%s]", messages, want)
+	}
+}
+
+// Testing the tags that cannot be combined.
+func TestBuildParser_invalidTags(t *testing.T) {
+	var elements = map[string]interface{}{
+		"enum and union": &struct {
+			Value int `enum:"a,b" union:"a=1"`
+		}{},
+		"count and min": &struct {
+			Values []int `min:"1" count:"2"`
+		}{},
+		"max less than min": &struct {
+			Values []int `min:"2" max:"1"`
+		}{},
+		"union of float": &struct {
+			Value float64 `union:"off=0"`
+		}{},
+		"invalid enum word": &struct {
+			Value int `enum:"a,1"`
+		}{},
+		"required pointer": &struct {
+			Value float64
+			Color *types.Color
+		}{},
+		"optional int before pointer": &struct {
+			Value float64
+			Index int          `optional:"true"`
+			Color *types.Color `optional:"true"`
+		}{},
+		"too many optional fields before pointer": &struct {
+			Value float64
+			W     float64              `optional:"true"`
+			Color *struct{ R float64 } `optional:"true"`
+		}{},
+	}
+	for name, element := range elements {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: the builder does not panic", name)
+				}
+			}()
+			buildParser(UnknownElement, element)
+		}()
+	}
+}
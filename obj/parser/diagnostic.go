@@ -0,0 +1,187 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The severity of a problem found while reading a file.
+type Severity uint8
+
+const (
+	Info    Severity = iota // Information that does not affect the result.
+	Warning                 // A problem due to which a part of the data is not used or is used partially.
+	Error                   // A problem due to which a part of the data is skipped.
+)
+
+// Converts a severity constant to its string representation.
+var severitiesMap = [...]string{"INFO", "WARNING", "ERROR"}
+
+// Converts a severity constant to its string representation.
+func (s Severity) String() string {
+	return severitiesMap[s]
+}
+
+// Describes a problem found while reading a file.
+type Diagnostic struct {
+	Severity    Severity    // The severity of the problem.
+	File        string      // The name of the file containing the problem, empty for the file being read.
+	Line        int         // The number of the line starting from 1, 0 if the problem does not refer to a line.
+	Column      int         // The column of the token starting from 1, 0 if the problem does not refer to a token.
+	Token       string      // The token that caused the problem, "eol" and "eof" for the ends of the line and file.
+	ElementType ElementType // The type of the element being read, UnknownElement if it is not determined.
+	Message     string      // The description of the problem.
+	LineString  string      // The line containing the token, empty if the problem does not refer to a token.
+}
+
+// Converts the diagnostic to the first line of its text representation in one of the formats:
+// [{severity}] line: {line number}, column: {column number}, token: '{token string}', message: {message}, the line will be skipped
+// [{severity}] line: {line number}, message: {message}
+// [{severity}] file: {file name}, line: {line number}, message: {message}
+// [{severity}] {message}
+// Diagnostics with a token are reported by the Parser, which always skips the line containing the token.
+func (d Diagnostic) String() string {
+	switch {
+	case d.Column > 0:
+		return fmt.Sprintf(
+			"[%s] line: %d, column: %d, token: '%s', message: %s, the line will be skipped",
+			d.Severity,
+			d.Line,
+			d.Column,
+			d.Token,
+			d.Message,
+		)
+	case d.File != "":
+		return fmt.Sprintf("[%s] file: %s, line: %d, message: %s", d.Severity, d.File, d.Line, d.Message)
+	case d.Line > 0:
+		return fmt.Sprintf("[%s] line: %d, message: %s", d.Severity, d.Line, d.Message)
+	default:
+		return fmt.Sprintf("[%s] %s", d.Severity, d.Message)
+	}
+}
+
+// A function that receives the diagnostics reported by a Parser or an importer.
+type DiagnosticHandler func(d Diagnostic)
+
+// Outputs the text representation of the diagnostic in w.
+// If the diagnostic refers to a token, the line containing it is output after that, highlighting the token.
+func WriteDiagnostic(w io.Writer, d Diagnostic) {
+	fmt.Fprintln(w, d.String())
+	if d.Column == 0 {
+		return
+	}
+	var (
+		severityString = d.Severity.String()
+		tokenLength    = len(d.Token)
+	)
+	if d.Token == "eol" || d.Token == "eof" {
+		tokenLength = 1
+	}
+	fmt.Fprintln(
+		w,
+		strings.Repeat(" ", len(severityString)+2),
+		"->",
+		d.LineString,
+		"\n",
+		strings.Repeat(" ", d.Column+len(severityString)+3),
+		strings.Repeat("^", tokenLength),
+	)
+}
+
+// Creates a DiagnosticHandler that outputs the text representation of the diagnostics in w.
+// See the WriteDiagnostic function.
+func TextHandler(w io.Writer) DiagnosticHandler {
+	return func(d Diagnostic) { WriteDiagnostic(w, d) }
+}
+
+// Collects the received diagnostics.
+// The Handle method can be used as a DiagnosticHandler.
+type Collector struct {
+	Diagnostics []Diagnostic // The received diagnostics in the order in which they were received.
+}
+
+// Appends the diagnostic to the collected ones.
+func (c *Collector) Handle(d Diagnostic) {
+	c.Diagnostics = append(c.Diagnostics, d)
+}
+
+// Returns the number of the collected diagnostics with the specified severity.
+func (c *Collector) Count(severity Severity) int {
+	var res = 0
+	for _, d := range c.Diagnostics {
+		if d.Severity == severity {
+			res++
+		}
+	}
+	return res
+}
+
+// The error returned by the Parser.Err method when the parsing is aborted.
+// Contains the summary of the problems found before the abort.
+type AbortError struct {
+	Diagnostic Diagnostic // The diagnostic that caused the abort.
+	Warnings   int        // The number of warnings reported before the abort, including the ignored ones.
+	Errors     int        // The number of errors reported before the abort, including the ignored ones.
+	Strict     bool       // true if the parsing is aborted in the Strict mode, false if the maximum number of errors is reached.
+}
+
+// Implementation of the Error method in the error interface.
+func (e *AbortError) Error() string {
+	var reason = "the maximum number of errors is reached"
+	if e.Strict {
+		reason = "the strict mode does not allow errors and warnings"
+	}
+	var line = fmt.Sprint(e.Diagnostic.Line)
+	if e.Diagnostic.File != "" {
+		line += " of " + e.Diagnostic.File
+	}
+	return fmt.Sprintf(
+		"the parsing is aborted at line %s, %s (errors: %d, warnings: %d): %s",
+		line,
+		reason,
+		e.Errors,
+		e.Warnings,
+		e.Diagnostic.Message,
+	)
+}
+
+// Delivers the diagnostics to the text output and the handler, taking into account the settings of ignoring them.
+// Aborts the parsing according to the strictness and the maximum number of errors.
+type reporter struct {
+	outputWriter   io.Writer         // Recipient of the text representation of the diagnostics.
+	handler        DiagnosticHandler // Recipient of the diagnostics, nil if not set.
+	ignoreWarnings bool              // If true, the warnings will not be delivered.
+	ignoreErrors   bool              // If true, the errors will not be delivered.
+	strictness     Strictness        // The way the lines that do not match the specification are handled.
+	maxErrors      int               // The number of errors after which the parsing is aborted, 0 if not limited.
+	warnings       int               // The number of reported warnings.
+	errors         int               // The number of reported errors.
+	aborted        *AbortError       // The reason of the abort, nil if the parsing is not aborted.
+}
+
+// Delivers the diagnostic if it is not ignored.
+// After the parsing is aborted, the diagnostics are not delivered.
+func (r *reporter) report(d Diagnostic) {
+	if r.aborted != nil {
+		return
+	}
+	switch d.Severity {
+	case Warning:
+		r.warnings++
+	case Error:
+		r.errors++
+	}
+	if !(d.Severity == Error && r.ignoreErrors || d.Severity == Warning && r.ignoreWarnings) {
+		if r.outputWriter != nil {
+			WriteDiagnostic(r.outputWriter, d)
+		}
+		if r.handler != nil {
+			r.handler(d)
+		}
+	}
+	var strict = r.strictness == Strict && d.Severity != Info
+	if strict || d.Severity == Error && r.maxErrors > 0 && r.errors >= r.maxErrors {
+		r.aborted = &AbortError{Diagnostic: d, Warnings: r.warnings, Errors: r.errors, Strict: strict}
+	}
+}
@@ -0,0 +1,256 @@
+package parser
+
+import (
+	"bufio"
+	"computer_graphics/obj/scanner"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Describes a line of the .obj file in the Document: a statement, a comment or a blank line.
+// A line ending with a backslash is continued on the next line, such lines are described by a single Statement.
+//
+// The Value of the statement can be changed in place or replaced,
+// the Document.Write method writes the changed statements from their values and the other statements as they were read.
+type Statement struct {
+	// The type of the statement, UnknownElement for the blank lines, the comment lines and the statements with an unknown name.
+	Type ElementType
+	// The name of the statement as it is written in the file, such as "v" or "usemtl", empty for the blank lines and the comment lines.
+	Name string
+	// The element read from the statement, which can be cast to the structure from the package types corresponding to the Type.
+	// nil if the statement is not supported or contains an error.
+	Value interface{}
+	// The comment at the end of the line including the '#' character, empty if there is no comment.
+	// The comment of a statement containing an error is not read.
+	Comment string
+	// The problems found while reading the statement, the line numbers refer to the lines of the file.
+	Diagnostics []Diagnostic
+
+	text    string      // The text of the line as it was read, without the end of the line.
+	ending  string      // The end of the line as it was read, empty for the last line without it and for the new statements.
+	read    bool        // true if the statement was read from the file.
+	initial ElementType // The type of the statement as it was read.
+	value   string      // The formatted value of the statement as it was read, empty if there is no value.
+	comment string      // The comment of the statement as it was read.
+}
+
+// Creates a new statement of the specified type with the specified value.
+// The value must be of the type returned by the Parser for the element type.
+// A statement of the UnknownElement type with a nil value is a blank line or a comment line if the Comment is set.
+func NewStatement(elementType ElementType, value interface{}) *Statement {
+	return &Statement{Type: elementType, Name: elementNamesMap[elementType], Value: value}
+}
+
+// Returns true if the statement is new or its type, value or comment has changed since it was read.
+// The changes of the statements without a value are not taken into account, except for the blank lines and the comment lines.
+func (s *Statement) changed() bool {
+	if !s.read {
+		return true
+	}
+	if s.Value == nil && s.Name != "" {
+		return false
+	}
+	if s.Type != s.initial || s.Comment != s.comment {
+		return true
+	}
+	if s.Value == nil {
+		return s.value != ""
+	}
+	var text, err = Format(s.Type, s.Value)
+	return err != nil || text != s.value
+}
+
+// Returns the text of the changed statement without the end of the line.
+func (s *Statement) format() (string, error) {
+	var text string
+	if s.Value != nil {
+		var err error
+		if text, err = Format(s.Type, s.Value); err != nil {
+			return "", err
+		}
+	}
+	var comment = s.Comment
+	if comment != "" && !strings.HasPrefix(comment, "#") {
+		comment = "# " + comment
+	}
+	if text != "" && comment != "" {
+		return text + " " + comment, nil
+	}
+	return text + comment, nil
+}
+
+// A lossless representation of the .obj file: all its statements, comments and blank lines in the order of the file.
+// Allows you to change some statements and write the file back,
+// so that the other lines are written exactly as they were read.
+type Document struct {
+	// The lines of the file. The statements can be changed, removed and added.
+	Statements []*Statement
+	// The end of the line written after the new statements, the end of the first line of the file by default.
+	LineEnding string
+}
+
+// Returns the logical lines of the data: the lines joined by the line continuations.
+// Each line is returned with the end of the line and the number of the physical lines it consists of.
+func splitLines(data string) (lines, endings []string, counts []int) {
+	var begin, count = 0, 1
+	for i := 0; i < len(data); i++ {
+		var ending string
+		switch {
+		case data[i] == '\n':
+			ending = "\n"
+		case data[i] == '\r' && i+1 < len(data) && data[i+1] == '\n':
+			ending = "\r\n"
+		case data[i] == '\r':
+			ending = "\r"
+		default:
+			continue
+		}
+		if i > begin && data[i-1] == '\\' {
+			i += len(ending) - 1
+			count++
+			continue
+		}
+		lines = append(lines, data[begin:i])
+		endings = append(endings, ending)
+		counts = append(counts, count)
+		i += len(ending) - 1
+		begin, count = i+1, 1
+	}
+	if begin < len(data) {
+		lines = append(lines, data[begin:])
+		endings = append(endings, "")
+		counts = append(counts, count)
+	}
+	return lines, endings, counts
+}
+
+// Wraps the scanner to skip the comments in the same way as the scanner does, remembering the last read comment.
+type commentScanner struct {
+	scanner.Scanner
+	skipComments bool   // true if the comments should be skipped.
+	comment      string // The last read comment including the '#' character.
+}
+
+// Creates a new commentScanner that reads the text and skips the comments by default.
+func newCommentScanner(text string) *commentScanner {
+	var s = &commentScanner{Scanner: scanner.NewScanner(strings.NewReader(text)), skipComments: true}
+	s.Scanner.SkipComments(false)
+	return s
+}
+
+// Implementation of the Next method in the Scanner interface.
+func (s *commentScanner) Next() (scanner.TokenType, string) {
+	var tokenType, token = s.Scanner.Next()
+	for tokenType == scanner.Comment {
+		s.comment = token
+		if !s.skipComments {
+			break
+		}
+		tokenType, token = s.Scanner.Next()
+	}
+	return tokenType, token
+}
+
+// Implementation of the IsSkipComments method in the Scanner interface.
+func (s *commentScanner) IsSkipComments() bool { return s.skipComments }
+
+// Implementation of the SkipComments method in the Scanner interface.
+func (s *commentScanner) SkipComments(skipComments bool) { s.skipComments = skipComments }
+
+// The byte order mark that the scanner skips at the beginning of the file.
+const bom = "\xEF\xBB\xBF"
+
+// Returns the name of the statement written in the line, empty if the line does not contain a statement.
+func statementName(text string) string {
+	text = strings.TrimLeft(strings.TrimPrefix(text, bom), " \t")
+	if i := strings.IndexAny(text, " \t#\\"); i >= 0 {
+		return text[:i]
+	}
+	return text
+}
+
+// Reads the statement from the text of the line with the specified number.
+func readStatement(text string, line int) *Statement {
+	var (
+		s = &Statement{Name: statementName(text), text: text, read: true}
+		c = newCommentScanner(text)
+		p = &parser{scanner: c}
+	)
+	p.handler = func(d Diagnostic) {
+		d.Line += line - 1
+		s.Diagnostics = append(s.Diagnostics, d)
+	}
+	var elementType, value, ok = p.readLine()
+	switch {
+	case elementType == EndOfFile:
+		s.Type = UnknownElement
+	case ok:
+		s.Type, s.Value = elementType, value
+		s.value, _ = Format(elementType, value)
+	default:
+		s.Type = elementType
+	}
+	if name, ok := elementNamesMap[s.Type]; ok {
+		s.Name = name
+	}
+	// The statement written in a comment has no comment.
+	if !strings.HasPrefix(s.Name, "#") {
+		s.Comment = c.comment
+	}
+	s.initial, s.comment = s.Type, s.Comment
+	return s
+}
+
+// Reads the document from the reader.
+// Each line of the file is read by the Parser in the Normal mode, the problems are stored in the statements.
+// Returns an error if the reader returns an error.
+func ReadDocument(reader io.Reader) (*Document, error) {
+	var data, err = io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		lines, endings, counts = splitLines(string(data))
+		document               = &Document{Statements: make([]*Statement, len(lines)), LineEnding: "\n"}
+		line                   = 1
+	)
+	if len(endings) > 0 && endings[0] != "" {
+		document.LineEnding = endings[0]
+	}
+	for i, text := range lines {
+		document.Statements[i] = readStatement(text, line)
+		document.Statements[i].ending = endings[i]
+		line += counts[i]
+	}
+	return document, nil
+}
+
+// Writes the document in the .obj format to w.
+// The statements that have not changed since they were read are written exactly as they were read,
+// the changed and new statements are written from their values with their comments.
+// Returns an error if a changed statement cannot be written or w returns an error.
+func (d *Document) Write(w io.Writer) error {
+	var buffer = bufio.NewWriter(w)
+	for i, s := range d.Statements {
+		var text = s.text
+		if s.changed() {
+			var err error
+			if text, err = s.format(); err != nil {
+				return fmt.Errorf("statement %d: %w", i+1, err)
+			}
+			if strings.HasPrefix(s.text, bom) {
+				text = bom + text
+			}
+		}
+		var ending = s.ending
+		// Only the last line of the file can be written without the end of the line.
+		if ending == "" && (i < len(d.Statements)-1 || !s.read) {
+			ending = d.LineEnding
+		}
+		if _, err := buffer.WriteString(text + ending); err != nil {
+			return err
+		}
+	}
+	return buffer.Flush()
+}
@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"bytes"
+	"computer_graphics/obj/parser/types"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Rescales the vertices and renames a material of a file, keeping the comments, blank lines and unknown statements.
+func ExampleReadDocument() {
+	var input = strings.Join([]string{
+		"# A square with a material.",
+		"mtllib square.mtl",
+		"",
+		"v 0 0 0   # origin",
+		"v 1.0 0.0 0.0",
+		"v 1 1 0",
+		"v 0 1 0",
+		"bevel on",
+		"custom_tag 42",
+		"usemtl red",
+		"f 1 2 3 \\",
+		"4",
+		"f 1 2 x",
+	}, "\n") + "\n"
+	var document, err = ReadDocument(strings.NewReader(input))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, s := range document.Statements {
+		switch value := s.Value.(type) {
+		case *types.Vertex:
+			value.X, value.Y, value.Z = value.X*2, value.Y*2, value.Z*2
+		case *types.UseMaterial:
+			value.Name = "blue"
+			s.Comment = "# was red"
+		}
+		for _, d := range s.Diagnostics {
+			fmt.Println(d)
+		}
+	}
+	document.Statements = append(document.Statements, NewStatement(Point, &types.Point{Vertices: []int{1}}))
+	if err = document.Write(os.Stdout); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	//[WARNING] line: 8, column: 1, token: 'bevel', message: unsupported element format - bevel interpolation, the line will be skipped
+	//[ERROR] line: 9, column: 1, token: 'custom_tag', message: error in the name of the element type, the line will be skipped
+	//[ERROR] line: 13, column: 8, token: 'x', message: invalid index, expected: INTEGER, received: WORD, the line will be skipped
+	//# A square with a material.
+	//mtllib square.mtl
+	//
+	//v 0 0 0   # origin
+	//v 2 0 0
+	//v 2 2 0
+	//v 0 2 0
+	//bevel on
+	//custom_tag 42
+	//usemtl blue # was red
+	//f 1 2 3 \
+	//4
+	//f 1 2 x
+	//p 1
+}
+
+// Reads and writes back all the test files without changes.
+func TestDocument_Write_unchanged(t *testing.T) {
+	var files, err = filepath.Glob("testdata/*.obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "../../examples/testdata/fox.obj")
+	for _, file := range files {
+		var data []byte
+		if data, err = os.ReadFile(file); err != nil {
+			t.Fatal(err)
+		}
+		var document *Document
+		if document, err = ReadDocument(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		var buffer bytes.Buffer
+		if err = document.Write(&buffer); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		if !bytes.Equal(buffer.Bytes(), data) {
+			t.Errorf("%s: the written document differs from the file", file)
+		}
+	}
+}
+
+// Formats every element read from the test files and reads it back into the same element.
+func TestFormat(t *testing.T) {
+	var files, err = filepath.Glob("testdata/*.obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		var data []byte
+		if data, err = os.ReadFile(file); err != nil {
+			t.Fatal(err)
+		}
+		var document *Document
+		if document, err = ReadDocument(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range document.Statements {
+			if s.Value == nil {
+				continue
+			}
+			var text string
+			if text, err = Format(s.Type, s.Value); err != nil {
+				t.Errorf("%s: %v", file, err)
+				continue
+			}
+			var p = NewParser(strings.NewReader(text))
+			p.Output(nil)
+			var elementType, element = p.Next()
+			if elementType != s.Type || fmt.Sprint(element) != fmt.Sprint(s.Value) {
+				t.Errorf("%s: %q is read as %v, want %v", file, text, element, s.Value)
+			}
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package parser
+
+import (
+	"computer_graphics/obj/parser/types"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Converts an element type constant to the name of the statement in the .obj file.
+var elementNamesMap = func() map[ElementType]string {
+	var res = make(map[ElementType]string, len(elementDeclarationsMap))
+	for name, elementType := range elementDeclarationsMap {
+		res[elementType] = name
+	}
+	return res
+}()
+
+// A function that converts the element into the values of its statement separated by spaces.
+// Returns false if the element is not of the type expected by the formatter.
+type elementFormatter func(element interface{}) (string, bool)
+
+// A registry of formatters for the elements that are read by the lineParser,
+// their format cannot be derived from the structure.
+// The other elements are formatted based on the fields of the structure in the same way as the buildParser reads them.
+var formattersRegistry = map[ElementType]elementFormatter{
+	Vertex:               formatVertex,
+	CurveSurfaceType:     formatCurveSurfaceType,
+	End:                  formatEnd,
+	SmoothingGroup:       formatSmoothingGroup,
+	MergingGroup:         formatMergingGroup,
+	CurveApproximation:   formatCurveApproximation,
+	SurfaceApproximation: formatSurfaceApproximation,
+	Call:                 formatCall,
+}
+
+// Converts the element to the text of its statement in the .obj file without a comment and the end of the line.
+// The element must be of the type returned by the Parser for the element type, so the text is read back into the same element.
+// The numbers are written in the shortest form that is read back exactly,
+// the optional parameters equal to zero at the end of the statement are not written.
+// Returns an error if the element type is not supported or the element cannot be written in the .obj format.
+func Format(elementType ElementType, element interface{}) (string, error) {
+	var name, ok = elementNamesMap[elementType]
+	if !ok {
+		return "", fmt.Errorf("the %s cannot be written", elementType)
+	}
+	var values string
+	if formatter, ok := formattersRegistry[elementType]; ok {
+		if values, ok = formatter(element); !ok {
+			return "", fmt.Errorf("unexpected type of the %s - %T", elementType, element)
+		}
+	} else {
+		var p, ok = parsersRegistry[elementType].(structParser)
+		if !ok {
+			return "", fmt.Errorf("unsupported element format - %s", elementType)
+		}
+		var value = reflect.ValueOf(element)
+		if !value.IsValid() || value.Type() != p.resultType() || value.IsNil() {
+			return "", fmt.Errorf("unexpected type of the %s - %T", elementType, element)
+		}
+		var err error
+		if values, err = formatValue(value.Elem(), reflect.StructField{}); err != nil {
+			return "", fmt.Errorf("the %s cannot be written: %w", elementType, err)
+		}
+	}
+	if values == "" {
+		return name, nil
+	}
+	return name + " " + values, nil
+}
+
+// Converts the number to the shortest string that is read back exactly.
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// Converts the numbers to the strings and joins them with spaces.
+func formatFloats(values ...float64) string {
+	var res = make([]string, len(values))
+	for i, value := range values {
+		res[i] = formatFloat(value)
+	}
+	return strings.Join(res, " ")
+}
+
+// Converts the value of the structure field to the string in the way the setters of the buildParser read it.
+// The elements of a slice are converted according to the tags of the slice field.
+func formatValue(value reflect.Value, field reflect.StructField) (string, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return "on", nil
+		}
+		return "off", nil
+	case reflect.Uint8:
+		if enum, ok := field.Tag.Lookup("enum"); ok {
+			return formatEnum(int(value.Uint()), enum, field)
+		}
+		if types.DirectionType(value.Uint()) == types.U {
+			return "u", nil
+		}
+		return "v", nil
+	case reflect.Int:
+		if enum, ok := field.Tag.Lookup("enum"); ok {
+			return formatEnum(int(value.Int()), enum, field)
+		}
+		if union, ok := field.Tag.Lookup("union"); ok {
+			return formatUnion(int(value.Int()), union), nil
+		}
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Float64:
+		return formatFloat(value.Float()), nil
+	case reflect.String:
+		return value.String(), checkString(value.String(), field)
+	case reflect.Struct:
+		return formatStruct(value, field.Tag.Get("delimiter"))
+	case reflect.Ptr:
+		if value.IsNil() {
+			return "", nil
+		}
+		return formatStruct(value.Elem(), "")
+	case reflect.Slice:
+		var res = make([]string, value.Len())
+		for i := range res {
+			var s, err = formatValue(value.Index(i), field)
+			if err != nil {
+				return "", err
+			}
+			res[i] = s
+		}
+		return strings.Join(res, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported type of the value - %s", value.Type())
+	}
+}
+
+// Converts the fields of the structure to the strings and joins them with the delimiter.
+// The optional fields equal to zero at the end of the structure are not written,
+// the other optional fields equal to zero are written as empty strings if the delimiter is a slash.
+func formatStruct(value reflect.Value, delimiter string) (string, error) {
+	var (
+		separator = " "
+		res       = make([]string, 0, value.NumField())
+		written   = 0 // The number of the fields up to the last one that must be written.
+	)
+	if delimiter == "slash" {
+		separator = "/"
+	}
+	for i := 0; i < value.NumField(); i++ {
+		var field = value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		var (
+			s        string
+			err      error
+			optional = field.Tag.Get("optional") == "true"
+		)
+		if !optional || !value.Field(i).IsZero() || separator != "/" {
+			if s, err = formatValue(value.Field(i), field); err != nil {
+				return "", err
+			}
+		}
+		res = append(res, s)
+		if !optional || !value.Field(i).IsZero() {
+			written = len(res)
+		}
+	}
+	return strings.Join(res[:written], separator), nil
+}
+
+// Converts the number of the word in the enum tag to the word.
+func formatEnum(index int, enum string, field reflect.StructField) (string, error) {
+	var words = strings.Split(enum, ",")
+	if index < 0 || index >= len(words) {
+		return "", fmt.Errorf("the %s is out of range - %d", fieldName(field), index)
+	}
+	return words[index], nil
+}
+
+// Converts the integer to the word mapped to it in the union tag or to the integer itself if there is no such word.
+func formatUnion(value int, union string) string {
+	for _, pair := range strings.Split(union, ",") {
+		if word, number, _ := strings.Cut(pair, "="); number == strconv.Itoa(value) {
+			return word
+		}
+	}
+	return strconv.Itoa(value)
+}
+
+// Returns the name of the field used in the messages: the name tag or the name of the field.
+func fieldName(field reflect.StructField) string {
+	if name := field.Tag.Get("name"); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// Returns an error if the value of the string field cannot be read back by the buildParser.
+func checkString(s string, field reflect.StructField) error {
+	var name = fieldName(field)
+	switch {
+	case s == "":
+		return fmt.Errorf("the %s is empty", name)
+	case strings.ContainsAny(s, "\r\n#"):
+		return fmt.Errorf("the %s contains the end of the line or a comment - %q", name, s)
+	case field.Tag.Get("text") == "true":
+		if strings.TrimSpace(s) != s {
+			return fmt.Errorf("the %s starts or ends with a space - %q", name, s)
+		}
+	case strings.ContainsAny(s, " \t"):
+		return fmt.Errorf("the %s contains a space - %q", name, s)
+	}
+	return nil
+}
+
+// Implementation of the elementFormatter for the types.Vertex.
+// The weight is written if it is not zero, the color is written if it is not nil.
+func formatVertex(element interface{}) (string, bool) {
+	var v, ok = element.(*types.Vertex)
+	if !ok || v == nil {
+		return "", false
+	}
+	var values = []float64{v.X, v.Y, v.Z}
+	if v.W != 0 {
+		values = append(values, v.W)
+	}
+	if v.Color != nil {
+		values = append(values, v.Color.R, v.Color.G, v.Color.B)
+	}
+	return formatFloats(values...), true
+}
+
+// The names of the free-form types in the order of the types.FreeFormType constants.
+var freeFormTypeNames = [...]string{"bmatrix", "bezier", "bspline", "cardinal", "taylor"}
+
+// Implementation of the elementFormatter for the types.CurveSurfaceType.
+func formatCurveSurfaceType(element interface{}) (string, bool) {
+	var t, ok = element.(*types.CurveSurfaceType)
+	if !ok || t == nil || int(t.Type) >= len(freeFormTypeNames) {
+		return "", false
+	}
+	if t.Rational {
+		return "rat " + freeFormTypeNames[t.Type], true
+	}
+	return freeFormTypeNames[t.Type], true
+}
+
+// Implementation of the elementFormatter for the types.End.
+func formatEnd(element interface{}) (string, bool) {
+	var e, ok = element.(*types.End)
+	return "", ok && e != nil
+}
+
+// Converts the group number of the smoothing or merging group statement to the string.
+// The value 0 is converted to "off".
+func formatGroupNumber(number int) string {
+	if number == 0 {
+		return "off"
+	}
+	return strconv.Itoa(number)
+}
+
+// Implementation of the elementFormatter for the types.SmoothingGroup.
+func formatSmoothingGroup(element interface{}) (string, bool) {
+	var g, ok = element.(*types.SmoothingGroup)
+	if !ok || g == nil {
+		return "", false
+	}
+	return formatGroupNumber(g.Number), true
+}
+
+// Implementation of the elementFormatter for the types.MergingGroup.
+// The resolution is written only if merging groups are turned on.
+func formatMergingGroup(element interface{}) (string, bool) {
+	var g, ok = element.(*types.MergingGroup)
+	if !ok || g == nil {
+		return "", false
+	}
+	if g.Number == 0 {
+		return formatGroupNumber(g.Number), true
+	}
+	return formatGroupNumber(g.Number) + " " + formatFloat(g.Resolution), true
+}
+
+// The words of the curve approximation techniques in the order of the types.ApproximationTechnique constants.
+var curveTechniqueNames = [...]string{"cparm", "cspace", "curv"}
+
+// Implementation of the elementFormatter for the types.CurveApproximation.
+// The maximum angle is written only for the curvature-dependent subdivision.
+func formatCurveApproximation(element interface{}) (string, bool) {
+	var c, ok = element.(*types.CurveApproximation)
+	if !ok || c == nil || int(c.Technique) >= len(curveTechniqueNames) {
+		return "", false
+	}
+	if c.Technique == types.CurvatureDependent {
+		return curveTechniqueNames[c.Technique] + " " + formatFloats(c.Value, c.MaxAngle), true
+	}
+	return curveTechniqueNames[c.Technique] + " " + formatFloat(c.Value), true
+}
+
+// The words of the surface approximation techniques in the order of the types.ApproximationTechnique constants.
+var surfaceTechniqueNames = [...]string{"cparma", "cspace", "curv", "cparmb"}
+
+// Implementation of the elementFormatter for the types.SurfaceApproximation.
+// The second parameter is written only for the cparma and curv techniques.
+func formatSurfaceApproximation(element interface{}) (string, bool) {
+	var s, ok = element.(*types.SurfaceApproximation)
+	if !ok || s == nil || int(s.Technique) >= len(surfaceTechniqueNames) {
+		return "", false
+	}
+	if s.Technique == types.ConstantParametric || s.Technique == types.CurvatureDependent {
+		return surfaceTechniqueNames[s.Technique] + " " + formatFloats(s.Value, s.Second), true
+	}
+	return surfaceTechniqueNames[s.Technique] + " " + formatFloat(s.Value), true
+}
+
+// Implementation of the elementFormatter for the types.Call.
+func formatCall(element interface{}) (string, bool) {
+	var c, ok = element.(*types.Call)
+	if !ok || c == nil || c.File == "" {
+		return "", false
+	}
+	return strings.Join(append([]string{c.File}, c.Arguments...), " "), true
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"computer_graphics/obj/parser/types"
+	"fmt"
+	"os"
+)
+
+// Contains the free-form geometry attributes that apply to all curves and surfaces described after them.
+type FreeFormAttributes struct {
+	Type    *types.CurveSurfaceType // The type of curve or surface, nil if not specified.
+	Degree  *types.Degree           // The degree of curve or surface, nil if not specified.
+	UMatrix *types.BasisMatrix      // The basis matrix in the u direction, nil if not specified.
+	VMatrix *types.BasisMatrix      // The basis matrix in the v direction, nil if not specified.
+	Step    *types.Step             // The step size, nil if not specified.
+	// The approximation technique for curves, nil if not specified.
+	CurveTechnique *types.CurveApproximation
+	// The approximation technique for surfaces, nil if not specified.
+	SurfaceTechnique *types.SurfaceApproximation
+}
+
+// Describes a complete free-form curve or surface:
+// the body statement, the attributes in effect for it and all the statements up to the end statement.
+// Only one of the Curve, Curve2D and Surface fields is not nil.
+type FreeForm struct {
+	Attributes    FreeFormAttributes    // The attributes in effect when the curve or surface was described.
+	Curve         *types.Curve          // The curve described by the block.
+	Curve2D       *types.Curve2D        // The 2D curve described by the block.
+	Surface       *types.Surface        // The surface described by the block.
+	UParameters   []float64             // The global parameter values in the u direction.
+	VParameters   []float64             // The global parameter values in the v direction.
+	Trims         []*types.Trim         // The outer trimming loops of the surface.
+	Holes         []*types.Hole         // The inner trimming loops of the surface.
+	SpecialCurves []*types.SpecialCurve // The special curves of the surface.
+	SpecialPoints []*types.SpecialPoint // The special points of the curve or surface.
+}
+
+// Implements the Parser interface by combining the free-form geometry statements read by another Parser into blocks.
+type blockParser struct {
+	Parser                            // The parser of separate elements, which also reports the errors of the blocks.
+	attributes     FreeFormAttributes // The current state of the free-form geometry attributes.
+	block          *FreeForm          // The block being read, nil if the body statement has not been read.
+	blockType      ElementType        // The type of the body statement of the block being read.
+	blockFirstLine int                // The line of the body statement of the block being read.
+}
+
+// Creates a Parser that combines the free-form geometry statements read by the Parser p into blocks.
+//
+// The cstype, deg, bmat, step, ctech and stech statements are not returned, they change the attributes of the following blocks.
+// The curv, curv2 and surf statements start a block, the parm, trim, hole, scrv and sp statements complement it.
+// When the end statement is reached, the Next method returns the type of the body statement
+// (Curve, Curve2D or Surface) and the *FreeForm describing the whole block.
+// All other elements are returned unchanged.
+//
+// By default, it outputs all errors in os.Stderr.
+// The errors of the blocks are reported by the Parser p, so the settings of the returned Parser are the settings of p.
+func NewBlockParser(p Parser) Parser {
+	var b = &blockParser{Parser: p}
+	b.Output(os.Stderr)
+	return b
+}
+
+// Reports an error diagnostic about the element on the line.
+func (b *blockParser) error(line int, elementType ElementType, msg string) {
+	b.Report(Diagnostic{Severity: Error, Line: line + 1, ElementType: elementType, Message: msg})
+}
+
+// Returns true if the block is being read and its body statement is a surface.
+// Otherwise, outputs an error message about the statement outside the surface description.
+func (b *blockParser) requireSurface(elementType ElementType) bool {
+	if b.block == nil || b.blockType != Surface {
+		b.error(
+			b.Line(),
+			elementType,
+			fmt.Sprintf("the %s statement must be inside the surface description, the element will be skipped", elementType),
+		)
+		return false
+	}
+	return true
+}
+
+// Implementation of the Next method in the Parser interface.
+func (b *blockParser) Next() (ElementType, interface{}) {
+	for {
+		var elementType, element = b.Parser.Next()
+		switch elementType {
+		case CurveSurfaceType:
+			b.attributes.Type = element.(*types.CurveSurfaceType)
+		case Degree:
+			b.attributes.Degree = element.(*types.Degree)
+		case BasisMatrix:
+			var matrix = element.(*types.BasisMatrix)
+			if matrix.Direction == types.U {
+				b.attributes.UMatrix = matrix
+			} else {
+				b.attributes.VMatrix = matrix
+			}
+		case Step:
+			b.attributes.Step = element.(*types.Step)
+		case CurveApproximation:
+			b.attributes.CurveTechnique = element.(*types.CurveApproximation)
+		case SurfaceApproximation:
+			b.attributes.SurfaceTechnique = element.(*types.SurfaceApproximation)
+		case Curve, Curve2D, Surface:
+			if b.block != nil {
+				b.error(
+					b.blockFirstLine,
+					b.blockType,
+					fmt.Sprintf("the end statement of the %s is not specified, the element will be skipped", b.blockType),
+				)
+			}
+			b.block = &FreeForm{Attributes: b.attributes}
+			b.blockType = elementType
+			b.blockFirstLine = b.Line()
+			switch elementType {
+			case Curve:
+				b.block.Curve = element.(*types.Curve)
+			case Curve2D:
+				b.block.Curve2D = element.(*types.Curve2D)
+			case Surface:
+				b.block.Surface = element.(*types.Surface)
+			}
+		case Parameter:
+			if b.block == nil {
+				b.error(b.Line(), elementType, "the parameter statement must be inside the curve or surface description, the element will be skipped")
+				continue
+			}
+			var parameter = element.(*types.Parameter)
+			if parameter.Direction == types.U {
+				b.block.UParameters = parameter.Values
+			} else {
+				b.block.VParameters = parameter.Values
+			}
+		case Trim:
+			if b.requireSurface(elementType) {
+				b.block.Trims = append(b.block.Trims, element.(*types.Trim))
+			}
+		case Hole:
+			if b.requireSurface(elementType) {
+				b.block.Holes = append(b.block.Holes, element.(*types.Hole))
+			}
+		case SpecialCurve:
+			if b.requireSurface(elementType) {
+				b.block.SpecialCurves = append(b.block.SpecialCurves, element.(*types.SpecialCurve))
+			}
+		case SpecialPoint:
+			if b.block == nil {
+				b.error(b.Line(), elementType, "the special point statement must be inside the curve or surface description, the element will be skipped")
+				continue
+			}
+			b.block.SpecialPoints = append(b.block.SpecialPoints, element.(*types.SpecialPoint))
+		case End:
+			if b.block == nil {
+				b.error(b.Line(), elementType, "the end statement does not complete any curve or surface description, the element will be skipped")
+				continue
+			}
+			var block = b.block
+			b.block = nil
+			return b.blockType, block
+		case EndOfFile:
+			if b.block != nil {
+				b.error(
+					b.blockFirstLine,
+					b.blockType,
+					fmt.Sprintf("the end statement of the %s is not specified, the element will be skipped", b.blockType),
+				)
+				b.block = nil
+			}
+			return elementType, element
+		default:
+			return elementType, element
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package parser
+
+import (
+	"bytes"
+	"computer_graphics/obj/scanner"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// If true, the TestGenerateMachines test writes the generated machines to the machinesFile instead of checking it.
+var generate = flag.Bool("generate", false, "write the generated machines to "+machinesFile)
+
+// The file containing the generated machines.
+const machinesFile = "machines_gen.go"
+
+// The names of the scanner.TokenType constants in the order of their values.
+var tokenNames = [scanner.TokensCount]string{
+	"scanner.Word",
+	"scanner.Integer",
+	"scanner.Float",
+	"scanner.Slash",
+	"scanner.Space",
+	"scanner.EOL",
+	"scanner.EOF",
+	"scanner.Unknown",
+	"scanner.Comment",
+}
+
+// The names of the scanner.Mode constants in the order of their values.
+var modeNames = []string{"scanner.Tokens", "scanner.Names", "scanner.Text"}
+
+// Returns the machines built by the buildParser for the element types of the parsersRegistry described by structures.
+// The elements registered by the Register function are not included.
+func builtMachines() map[ElementType]*finiteStateMachine {
+	var machines = make(map[ElementType]*finiteStateMachine)
+	for elementType := ElementType(0); elementType < EndOfFile; elementType++ {
+		if p, ok := parsersRegistry[elementType].(structParser); ok {
+			var element = reflect.New(p.resultType().Elem()).Interface()
+			machines[elementType] = buildParser(elementType, element).(*finiteStateMachine)
+		}
+	}
+	return machines
+}
+
+// Returns the statements that assign the constant values to the target for the words
+// and return the error for the other words.
+func switchCode(target string, words []string, values []string, message string) string {
+	var code strings.Builder
+	code.WriteString("switch token {\n")
+	for i, word := range words {
+		fmt.Fprintf(&code, "case %q:\n%s = %s\n", word, target, values[i])
+	}
+	fmt.Fprintf(&code, "default:\nreturn errors.New(%q)\n}\nreturn nil\n", message)
+	return code.String()
+}
+
+// Returns the statements of the action performed by the setter, which writes the token to the target of the type t.
+func actionCode(s setter, target string, t reflect.Type) string {
+	switch s := s.(type) {
+	case *structSetter:
+		var field = t.Field(s.fieldNumber)
+		return actionCode(s.setter, target+"."+field.Name, field.Type)
+	case *sliceSetter:
+		return actionCode(s.setter, fmt.Sprintf("%s[len(%s)-1]", target, target), t.Elem())
+	case *sliceAppender:
+		return fmt.Sprintf("appendZero(&%s)\n", target) + actionCode(s.setter, target, t)
+	case *boolSetter:
+		return switchCode(target, []string{"on", "off"}, []string{"true", "false"}, s.error.Error())
+	case *directionTypeSetter:
+		return switchCode(target, []string{"v", "u"}, []string{"types.V", "types.U"}, s.error.Error())
+	case *enumSetter:
+		var values = make([]string, len(s.words))
+		for i := range values {
+			values[i] = fmt.Sprint(i)
+		}
+		return switchCode(target, s.words, values, s.error.Error())
+	case *unionSetter:
+		var (
+			code  strings.Builder
+			words = make([]string, 0, len(s.values))
+		)
+		for word := range s.values {
+			words = append(words, word)
+		}
+		sort.Strings(words)
+		code.WriteString("switch token {\n")
+		for _, word := range words {
+			fmt.Fprintf(&code, "case %q:\n%s = %d\nreturn nil\n", word, target, s.values[word])
+		}
+		fmt.Fprintf(&code, "}\nif _, err := strconv.Atoi(token); err != nil {\nreturn errors.New(%q)\n}\n", s.error.Error())
+		return code.String() + actionCode(&s.intSetter, target, t)
+	case *intSetter:
+		return fmt.Sprintf(
+			"var value, err = strconv.ParseInt(token, 10, 64)\nif err != nil {\nreturn errors.New(%q)\n}\n%s = %s(value)\nreturn nil\n",
+			s.error.Error(),
+			target,
+			t,
+		)
+	case *floatSetter:
+		var value = "value"
+		if t.String() != "float64" {
+			value = fmt.Sprintf("%s(value)", t)
+		}
+		return fmt.Sprintf(
+			"var value, err = strconv.ParseFloat(token, 64)\nif err != nil {\nreturn errors.New(%q)\n}\n%s = %s\nreturn nil\n",
+			s.error.Error(),
+			target,
+			value,
+		)
+	case *stringSetter:
+		return fmt.Sprintf("%s = token\nreturn nil\n", target)
+	case *trailingSetter:
+		return trailingCode(s, target, t)
+	case *flagSetter:
+		return fmt.Sprintf("if token == %q {\n%s.%s = true\nreturn nil\n}\n", s.word, target, t.Field(s.fieldNumber).Name) +
+			actionCode(s.next, target, t)
+	case *conditionalSetter:
+		return fmt.Sprintf("if %s {\nreturn errors.New(%q)\n}\n", conditionCode(s.condition, target, t, false), s.error.Error()) +
+			actionCode(s.setter, target, t)
+	default:
+		panic(fmt.Sprintf("the code of the %T cannot be generated", s))
+	}
+}
+
+// Returns the expression of the condition on the field of the target of the type t, negated if holds is false.
+func conditionCode(c condition, target string, t reflect.Type, holds bool) string {
+	var field = target + "." + t.Field(c.fieldNumber).Name
+	if c.values == nil {
+		if holds {
+			return field
+		}
+		return "!" + field
+	}
+	var (
+		terms    = make([]string, len(c.values))
+		operator = "!="
+		join     = " && "
+	)
+	if holds != c.negated {
+		operator, join = "==", " || "
+	}
+	for i, value := range c.values {
+		terms[i] = fmt.Sprintf("%s %s %d", field, operator, value)
+	}
+	return strings.Join(terms, join)
+}
+
+// Returns the fields of the target of the type t in which the trailingSetter writes the specified number of values.
+func trailingPlaces(s *trailingSetter, target string, t reflect.Type, count int) []string {
+	var (
+		fields, group = trailingLayout(count, len(s.fields), s.size)
+		pointer       = t.Field(s.pointer)
+		res           = make([]string, 0, count)
+	)
+	for _, field := range s.fields[:fields] {
+		res = append(res, target+"."+t.Field(field).Name)
+	}
+	for i := 0; i < group; i++ {
+		res = append(res, target+"."+pointer.Name+"."+pointer.Type.Elem().Field(i).Name)
+	}
+	return res
+}
+
+// Returns the statements of the action performed by the trailingSetter,
+// which moves the values read before the token to their places and writes the token to the target of the type t.
+func trailingCode(s *trailingSetter, target string, t reflect.Type) string {
+	var (
+		code             strings.Builder
+		before           = trailingPlaces(s, target, t, s.read)
+		after            = trailingPlaces(s, target, t, s.read+1)
+		_, groupBefore   = trailingLayout(s.read, len(s.fields), s.size)
+		_, groupAfter    = trailingLayout(s.read+1, len(s.fields), s.size)
+		targets, sources []string
+	)
+	fmt.Fprintf(&code, "var value, err = strconv.ParseFloat(token, 64)\nif err != nil {\nreturn errors.New(%q)\n}\n", s.error.Error())
+	if groupBefore == 0 && groupAfter > 0 {
+		var pointer = t.Field(s.pointer)
+		fmt.Fprintf(&code, "%s.%s = new(%s)\n", target, pointer.Name, pointer.Type.Elem())
+	}
+	for i, place := range after {
+		var source = "value"
+		if i < len(before) {
+			source = before[i]
+		}
+		if place != source {
+			targets, sources = append(targets, place), append(sources, source)
+		}
+	}
+	// The optional fields that take no values are cleared.
+	for _, place := range before {
+		var taken = false
+		for _, p := range after {
+			taken = taken || p == place
+		}
+		if !taken {
+			targets, sources = append(targets, place), append(sources, "0")
+		}
+	}
+	fmt.Fprintf(&code, "%s = %s\nreturn nil\n", strings.Join(targets, ", "), strings.Join(sources, ", "))
+	return code.String()
+}
+
+// Writes the tables and the constructor of the generatedMachine reading the element of the type.
+func writeMachine(code *bytes.Buffer, elementType ElementType, m *finiteStateMachine) string {
+	var (
+		t      = m.resultType().Elem()
+		prefix = strings.ToLower(t.Name()[:1]) + t.Name()[1:]
+		name   = "new" + t.Name() + "Machine"
+		target = "element"
+	)
+	if t.Kind() != reflect.Struct {
+		target = "*element"
+	}
+	fmt.Fprintf(code, "\n// The transition table of the %s.\nvar %sMatrix = [][scanner.TokensCount]stateType{\n", elementType, prefix)
+	for _, row := range m.matrix {
+		var states = make([]string, len(row))
+		for i, state := range row {
+			states[i] = fmt.Sprint(state)
+		}
+		fmt.Fprintf(code, "{%s},\n", strings.Join(states, ", "))
+	}
+	fmt.Fprintf(code, "}\n\n// The error messages of the %s.\nvar %sErrors = [][scanner.TokensCount]string{\n", elementType, prefix)
+	for _, row := range m.errors {
+		var messages []string
+		for i, message := range row {
+			if message != noErrorMessage {
+				messages = append(messages, fmt.Sprintf("%s: %q", tokenNames[i], message))
+			}
+		}
+		if len(messages) == 0 {
+			code.WriteString("{},\n")
+		} else {
+			fmt.Fprintf(code, "{\n%s,\n},\n", strings.Join(messages, ",\n"))
+		}
+	}
+	fmt.Fprintf(code, "}\n\n// The scanner modes of the %s.\nvar %sModes = []scanner.Mode{", elementType, prefix)
+	for i, mode := range m.modes {
+		if i > 0 {
+			code.WriteString(", ")
+		}
+		code.WriteString(modeNames[mode])
+	}
+	code.WriteString("}\n")
+	fmt.Fprintf(code, "\n// Creates a new elementParser of the %s.\nfunc %s() elementParser {\n", elementType, name)
+	fmt.Fprintf(code, "var element = new(%s)\n", t)
+	fmt.Fprintf(code, "var m = newGeneratedMachine(%sMatrix, %sErrors, %sModes, element)\n", prefix, prefix, prefix)
+	fmt.Fprintf(code, "m.actions[first] = func(string) error {\nelement = new(%s)\nreturn nil\n}\n", t)
+	for state, s := range m.setters {
+		if s != nil {
+			fmt.Fprintf(code, "m.actions[%d] = func(token string) error {\n%s}\n", state, actionCode(s, target, t))
+		}
+	}
+	for state, c := range m.checks {
+		if c != nil {
+			fmt.Fprintf(
+				code,
+				"m.checks[%d] = func() error {\nif %s {\nreturn errors.New(%q)\n}\nreturn nil\n}\n",
+				state,
+				conditionCode(c.condition, target, t, true),
+				c.message,
+			)
+		}
+	}
+	code.WriteString("m.current = func() interface{} { return element }\nreturn m\n}\n")
+	return name
+}
+
+// Returns the formatted source code of the machinesFile.
+func generateMachines() ([]byte, error) {
+	var (
+		machines     = builtMachines()
+		code         bytes.Buffer
+		constructors strings.Builder
+	)
+	for elementType := ElementType(0); elementType < EndOfFile; elementType++ {
+		if m, ok := machines[elementType]; ok {
+			fmt.Fprintf(&constructors, "%s: %s,\n", elementTypeNames[elementType], writeMachine(&code, elementType, m))
+		}
+	}
+	var header = "// Code generated by TestGenerateMachines with the -generate flag; DO NOT EDIT.\n\n" +
+		"//go:build !nogenerated\n\npackage parser\n\nimport (\n" +
+		"\"computer_graphics/obj/parser/types\"\n\"computer_graphics/obj/scanner\"\n\"errors\"\n\"strconv\"\n)\n\n" +
+		"// The elementParsers of the element types generated from the machines built by the buildParser.\n" +
+		"var generatedParsers = map[ElementType]func() elementParser{\n" + constructors.String() + "}\n"
+	return format.Source(append([]byte(header), code.Bytes()...))
+}
+
+// The names of the ElementType constants in the order of their values.
+var elementTypeNames = [EndOfFile]string{
+	"Vertex", "VertexTexture", "VertexNormal", "VertexParameter", "CurveSurfaceType", "Degree", "BasisMatrix", "Step",
+	"Point", "Line", "Face", "Curve", "Curve2D", "Surface", "Parameter", "Trim", "Hole", "SpecialCurve", "SpecialPoint",
+	"End", "Connect", "Group", "SmoothingGroup", "MergingGroup", "Object", "BevelInterpolation", "ColorInterpolation",
+	"DissolveInterpolation", "LevelOfDetail", "MapLibrary", "UseMapping", "UseMaterial", "MaterialLibrary",
+	"ShadowObject", "TraceObject", "CurveApproximation", "SurfaceApproximation", "Call", "Scmp", "Csh",
+}
+
+// Generates the machines and writes them to the machinesFile with the -generate flag,
+// otherwise checks that the machinesFile is up to date.
+func TestGenerateMachines(t *testing.T) {
+	var code, err = generateMachines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *generate {
+		if err = os.WriteFile(machinesFile, code, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	var file []byte
+	if file, err = os.ReadFile(machinesFile); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(file, code) {
+		t.Errorf("%s is out of date, run go generate", machinesFile)
+	}
+}
+
+// Reads the files and returns the text representation of the read elements and the diagnostics.
+func readFiles(files []string, t *testing.T) string {
+	var res strings.Builder
+	for _, file := range files {
+		var data, err = os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var p = NewParser(bytes.NewReader(data))
+		p.Output(&res)
+		for elementType, element := p.Next(); elementType != EndOfFile; elementType, element = p.Next() {
+			fmt.Fprintf(&res, "%s: %v\n", elementType, element)
+		}
+	}
+	return res.String()
+}
+
+// Checks that the generated machines have the same tables as the machines built by the buildParser
+// and read the same elements from the test files.
+func TestGeneratedMachines(t *testing.T) {
+	if len(generatedParsers) == 0 {
+		t.Skip("the generated machines are excluded from the build")
+	}
+	var machines = builtMachines()
+	if len(generatedParsers) != len(machines) {
+		t.Fatalf("Incorrect number of the generated machines, got: %d, want: %d", len(generatedParsers), len(machines))
+	}
+	for elementType, m := range machines {
+		var g, ok = parsersRegistry[elementType].(*generatedMachine)
+		switch {
+		case !ok:
+			t.Errorf("The machine of the %s is not generated", elementType)
+		case !reflect.DeepEqual(g.matrix, m.matrix):
+			t.Errorf("Invalid matrix of the %s", elementType)
+		case !reflect.DeepEqual(g.errors, m.errors):
+			t.Errorf("Invalid error messages of the %s", elementType)
+		case !reflect.DeepEqual(g.modes, m.modes):
+			t.Errorf("Invalid modes of the %s", elementType)
+		default:
+			for state := range m.checks {
+				if (g.checks[state] == nil) != (m.checks[state] == nil) {
+					t.Errorf("Invalid checks of the %s", elementType)
+					break
+				}
+			}
+		}
+	}
+	var files, err = filepath.Glob("testdata/*.obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "../../examples/testdata/fox.obj")
+	var generated = readFiles(files, t)
+	// Reading the files again with the machines built by the buildParser.
+	var registry = append([]elementParser(nil), parsersRegistry...)
+	defer func() { parsersRegistry = registry }()
+	for elementType, m := range machines {
+		parsersRegistry[elementType] = m
+	}
+	if built := readFiles(files, t); generated != built {
+		t.Error("The generated machines read the files differently from the machines built by the buildParser")
+	}
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"computer_graphics/obj/scanner"
+	"reflect"
+)
+
+// The machines of the elements read by the buildParser are generated by the TestGenerateMachines test into machines_gen.go.
+// The test builds the machines with the buildParser, so the machines_gen.go must be regenerated after changing
+// the structures of the package types or the builder.
+// The nogenerated build tag excludes the generated machines, so that the package can be built if they are out of date.
+//go:generate go test -tags nogenerated -run TestGenerateMachines -args -generate
+
+// Implemented by the elementParsers that read the elements described by the structures of the package types.
+type structParser interface {
+	// Returns the type of the pointer to the structure returned by the result method.
+	resultType() reflect.Type
+}
+
+// The finiteStateMachine generated from the machine built by the buildParser.
+// The actions write the tokens to the fields of the element directly, without reflection.
+// The tables of the machine are shared by all the machines of the same element type.
+type generatedMachine struct {
+	matrix      [][scanner.TokensCount]stateType // The transition table.
+	errors      [][scanner.TokensCount]string    // Error messages returned when transitioning to the err state.
+	modes       []scanner.Mode                   // Modes in which the scanner reads the token following a certain state.
+	actions     []func(token string) error       // Actions that are performed when transitioning to a certain state, nil if there are none.
+	checks      []func() error                   // Checks performed when the end of the line is read in a certain state, nil if there are none.
+	current     func() interface{}               // Returns the element being read.
+	pointerType reflect.Type                     // The type of the pointer to the element being read.
+}
+
+// Implementation of the transition method in the elementParser interface.
+func (m *generatedMachine) transition(tokenType scanner.TokenType, state stateType) stateType {
+	return m.matrix[state][tokenType]
+}
+
+// Implementation of the action method in the elementParser interface.
+func (m *generatedMachine) action(state stateType, token string) error {
+	if a := m.actions[state]; a != nil {
+		return a(token)
+	}
+	return nil
+}
+
+// Implementation of the message method in the elementParser interface.
+func (m *generatedMachine) message(tokenType scanner.TokenType, state stateType) string {
+	return m.errors[state][tokenType]
+}
+
+// Implementation of the mode method in the elementParser interface.
+func (m *generatedMachine) mode(state stateType) scanner.Mode { return m.modes[state] }
+
+// Implementation of the check method in the elementParser interface.
+func (m *generatedMachine) check(state stateType) error {
+	if c := m.checks[state]; c != nil {
+		return c()
+	}
+	return nil
+}
+
+// Implementation of the result method in the elementParser interface.
+func (m *generatedMachine) result() interface{} { return m.current() }
+
+// Implementation of the resultType method in the structParser interface.
+func (m *generatedMachine) resultType() reflect.Type { return m.pointerType }
+
+// Creates a new generatedMachine with the specified tables that reads the element of the specified type.
+// The actions, the checks and the result function are set by the generated code.
+func newGeneratedMachine(
+	matrix [][scanner.TokensCount]stateType,
+	errors [][scanner.TokensCount]string,
+	modes []scanner.Mode,
+	element interface{},
+) *generatedMachine {
+	return &generatedMachine{
+		matrix:      matrix,
+		errors:      errors,
+		modes:       modes,
+		actions:     make([]func(token string) error, len(matrix)),
+		checks:      make([]func() error, len(matrix)),
+		pointerType: reflect.TypeOf(element),
+	}
+}
+
+// Appends the zero value to the slice, the generated actions call it before writing to the new element of the slice.
+func appendZero[T any](slice *[]T) {
+	var zero T
+	*slice = append(*slice, zero)
+}
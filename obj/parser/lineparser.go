@@ -0,0 +1,224 @@
+package parser
+
+import (
+	"computer_graphics/obj/parser/types"
+	"computer_graphics/obj/scanner"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// A function that converts the values read from the line into an element.
+// Returns an error if the values do not describe the element.
+type lineConverter func(values []string, valueTypes []scanner.TokenType) (interface{}, error)
+
+// The states of the lineParser.
+const (
+	lineFirst stateType = first + iota // The first space after the name of the element is read.
+	lineValue                          // A value is read.
+	lineSpace                          // A space after a value is read.
+)
+
+// Implements the elementParser interface for elements whose format cannot be described by a structure for the buildParser.
+// Collects all the values of the line separated by spaces
+// and converts them into the element using the converter when the end of the line is reached.
+// The values are read by the scanner in the specified mode, so they can be names, such as file names.
+type lineParser struct {
+	elementType ElementType         // The type of the element to be read.
+	convert     lineConverter       // Converts the read values into the element.
+	tokenMode   scanner.Mode        // The mode in which the values are read.
+	values      []string            // The values read from the current line.
+	valueTypes  []scanner.TokenType // The types of the values read from the current line.
+	element     interface{}         // The element read from the last line.
+	error       string              // The message of the error returned by the converter.
+}
+
+// Converts the read values into the element.
+// Returns the start state in case of success and the err state otherwise.
+func (p *lineParser) complete() stateType {
+	var element, e = p.convert(p.values, p.valueTypes)
+	if e != nil {
+		p.error = e.Error()
+		return err
+	}
+	p.element = element
+	return start
+}
+
+// Implementation of the transition method in the elementParser interface.
+func (p *lineParser) transition(tokenType scanner.TokenType, state stateType) stateType {
+	switch state {
+	case start:
+		switch tokenType {
+		case scanner.Space:
+			return lineFirst
+		case scanner.EOL, scanner.EOF:
+			p.values = p.values[:0]
+			p.valueTypes = p.valueTypes[:0]
+			return p.complete()
+		}
+	case lineFirst, lineSpace:
+		switch tokenType {
+		case scanner.Word, scanner.Integer, scanner.Float:
+			p.valueTypes = append(p.valueTypes, tokenType)
+			return lineValue
+		case scanner.EOL, scanner.EOF:
+			return p.complete()
+		}
+	case lineValue:
+		switch tokenType {
+		case scanner.Space:
+			return lineSpace
+		case scanner.EOL, scanner.EOF:
+			return p.complete()
+		}
+	}
+	p.error = ""
+	return err
+}
+
+// Implementation of the action method in the elementParser interface.
+func (p *lineParser) action(state stateType, token string) error {
+	switch state {
+	case lineFirst:
+		p.values = p.values[:0]
+		p.valueTypes = p.valueTypes[:0]
+	case lineValue:
+		p.values = append(p.values, token)
+	}
+	return nil
+}
+
+// Implementation of the mode method in the elementParser interface.
+func (p *lineParser) mode(state stateType) scanner.Mode {
+	if state == lineFirst || state == lineSpace {
+		return p.tokenMode
+	}
+	return scanner.Tokens
+}
+
+// Implementation of the message method in the elementParser interface.
+func (p *lineParser) message(tokenType scanner.TokenType, state stateType) string {
+	if p.error != "" {
+		return p.error
+	}
+	if state == start {
+		return impossibleTokenInStartStateMessage(tokenType)
+	}
+	return impossibleTokenMessage(fmt.Sprintf("parameters of the %s", p.elementType), tokenType)
+}
+
+// Implementation of the check method in the elementParser interface.
+func (p *lineParser) check(stateType) error { return nil }
+
+// Implementation of the result method in the elementParser interface.
+func (p *lineParser) result() interface{} { return p.element }
+
+// Creates an elementParser that reads the values of the line and converts them into the element using the converter.
+func newLineParser(elementType ElementType, convert lineConverter) *lineParser {
+	return &lineParser{
+		elementType: elementType,
+		convert:     convert,
+		values:      make([]string, 0, initMatrixSize),
+		valueTypes:  make([]scanner.TokenType, 0, initMatrixSize),
+	}
+}
+
+// Creates an elementParser that reads the names of the line separated by spaces
+// and converts them into the element using the converter.
+func newNameParser(elementType ElementType, convert lineConverter) *lineParser {
+	var p = newLineParser(elementType, convert)
+	p.tokenMode = scanner.Names
+	return p
+}
+
+// Converts the values of the float parameters to float64.
+// Returns an error if the number of values does not match the names of the parameters.
+func convertFloatParameters(
+	described string, // The description of the values preceding the parameters.
+	names []string, // The names of the parameters.
+	values []string, // The values of the parameters.
+	valueTypes []scanner.TokenType, // The types of the values.
+) ([]float64, error) {
+	if len(values) < len(names) {
+		return nil, errors.New(parametersNotSpecifiedMessage(names[len(values):]))
+	}
+	if len(values) > len(names) {
+		return nil, fmt.Errorf("unexpected value received after describing the %s - %s", described, values[len(names)])
+	}
+	var res = make([]float64, len(values))
+	for i, value := range values {
+		if valueTypes[i] != scanner.Integer && valueTypes[i] != scanner.Float {
+			return nil, errors.New(invalidTokenMessage(names[i], scanner.Float, valueTypes[i]))
+		}
+		var val, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the token to a float when reading %s", names[i])
+		}
+		res[i] = val
+	}
+	return res, nil
+}
+
+// Converts the values of the call statement into the types.Call.
+func convertCall(values []string, _ []scanner.TokenType) (interface{}, error) {
+	if len(values) == 0 {
+		return nil, errors.New("parameter file name is not specified")
+	}
+	var element = types.NewCall()
+	element.File = values[0]
+	element.Arguments = append(element.Arguments, values[1:]...)
+	return element, nil
+}
+
+// Returns the names of the parameters of the v statement with the specified number of values.
+// Six values are the coordinates and the color: x y z r g b,
+// seven values are the coordinates, the weight and the color: x y z w r g b.
+// Any other number of values is read as the coordinates and the optional weight: x y z [w].
+func vertexParameterNames(count int) []string {
+	var (
+		coordinates = []string{"X coordinate", "Y coordinate", "Z coordinate"}
+		weight      = "weight parameter"
+		color       = []string{"red component", "green component", "blue component"}
+	)
+	switch {
+	case count < 4:
+		return coordinates
+	case count < 6:
+		return append(coordinates, weight)
+	case count == 6:
+		return append(coordinates, color...)
+	default:
+		return append(append(coordinates, weight), color...)
+	}
+}
+
+// Converts the float parameters of the v statement read by their names into the types.Vertex.
+func convertVertexParameters(names []string, values []string, valueTypes []scanner.TokenType) (interface{}, error) {
+	var params, err = convertFloatParameters(Vertex.String(), names, values, valueTypes)
+	if err != nil {
+		return nil, err
+	}
+	var element = types.NewVertex()
+	element.X, element.Y, element.Z = params[0], params[1], params[2]
+	switch len(params) {
+	case 4:
+		element.W = params[3]
+	case 6:
+		element.Color = &types.Color{R: params[3], G: params[4], B: params[5]}
+	case 7:
+		element.W = params[3]
+		element.Color = &types.Color{R: params[4], G: params[5], B: params[6]}
+	}
+	return element, nil
+}
+
+// Converts the values of the v statement into the types.Vertex, accepting extra values at the end.
+// The values following the weight or the color are ignored.
+func convertLenientVertex(values []string, valueTypes []scanner.TokenType) (interface{}, error) {
+	var names = vertexParameterNames(len(values))
+	if len(values) > len(names) {
+		values, valueTypes = values[:len(names)], valueTypes[:len(names)]
+	}
+	return convertVertexParameters(names, values, valueTypes)
+}
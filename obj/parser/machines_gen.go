@@ -0,0 +1,6436 @@
+// Code generated by TestGenerateMachines with the -generate flag; DO NOT EDIT.
+
+//go:build !nogenerated
+
+package parser
+
+import (
+	"computer_graphics/obj/parser/types"
+	"computer_graphics/obj/scanner"
+	"errors"
+	"strconv"
+)
+
+// The elementParsers of the element types generated from the machines built by the buildParser.
+var generatedParsers = map[ElementType]func() elementParser{
+	Vertex:               newVertexMachine,
+	VertexTexture:        newVertexTextureMachine,
+	VertexNormal:         newVertexNormalMachine,
+	VertexParameter:      newVertexParameterMachine,
+	CurveSurfaceType:     newCurveSurfaceTypeMachine,
+	Degree:               newDegreeMachine,
+	BasisMatrix:          newBasisMatrixMachine,
+	Step:                 newStepMachine,
+	Point:                newPointMachine,
+	Line:                 newLineMachine,
+	Face:                 newFaceMachine,
+	Curve:                newCurveMachine,
+	Curve2D:              newCurve2DMachine,
+	Surface:              newSurfaceMachine,
+	Parameter:            newParameterMachine,
+	Trim:                 newTrimMachine,
+	Hole:                 newHoleMachine,
+	SpecialCurve:         newSpecialCurveMachine,
+	SpecialPoint:         newSpecialPointMachine,
+	End:                  newEndMachine,
+	Connect:              newConnectMachine,
+	Group:                newGroupMachine,
+	SmoothingGroup:       newSmoothingGroupMachine,
+	MergingGroup:         newMergingGroupMachine,
+	Object:               newObjectMachine,
+	MapLibrary:           newMapLibraryMachine,
+	UseMapping:           newUseMappingMachine,
+	UseMaterial:          newUseMaterialMachine,
+	MaterialLibrary:      newMaterialLibraryMachine,
+	CurveApproximation:   newCurveApproximationMachine,
+	SurfaceApproximation: newSurfaceApproximationMachine,
+}
+
+// The transition table of the vertex.
+var vertexMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 7, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 9, 9, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 10, 0, 0, 1, 1},
+	{1, 11, 11, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 12, 1, 1, 1, 1},
+	{1, 13, 13, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 14, 0, 0, 1, 1},
+	{1, 15, 15, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 16, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the vertex.
+var vertexErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the vertex are not specified",
+		scanner.EOF:     "all parameters of the vertex are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid X coordinate, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid X coordinate, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the X coordinate - SPACE",
+		scanner.EOL:     "parameters X coordinate, Y coordinate, Z coordinate are not specified",
+		scanner.EOF:     "parameters X coordinate, Y coordinate, Z coordinate are not specified",
+		scanner.Unknown: "invalid X coordinate, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the X coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between X coordinate and Y coordinate - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between X coordinate and Y coordinate - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between X coordinate and Y coordinate - FLOAT",
+		scanner.Slash:   "invalid delimiter between X coordinate and Y coordinate, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters Y coordinate, Z coordinate are not specified",
+		scanner.EOF:     "parameters Y coordinate, Z coordinate are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between X coordinate and Y coordinate - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between X coordinate and Y coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid Y coordinate, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid Y coordinate, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the Y coordinate - SPACE",
+		scanner.EOL:     "parameters Y coordinate, Z coordinate are not specified",
+		scanner.EOF:     "parameters Y coordinate, Z coordinate are not specified",
+		scanner.Unknown: "invalid Y coordinate, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the Y coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between Y coordinate and Z coordinate - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between Y coordinate and Z coordinate - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between Y coordinate and Z coordinate - FLOAT",
+		scanner.Slash:   "invalid delimiter between Y coordinate and Z coordinate, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter Z coordinate is not specified",
+		scanner.EOF:     "parameter Z coordinate is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between Y coordinate and Z coordinate - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between Y coordinate and Z coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid Z coordinate, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid Z coordinate, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the Z coordinate - SPACE",
+		scanner.EOL:     "parameter Z coordinate is not specified",
+		scanner.EOF:     "parameter Z coordinate is not specified",
+		scanner.Unknown: "invalid Z coordinate, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the Z coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between Z coordinate and weight parameter - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between Z coordinate and weight parameter - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between Z coordinate and weight parameter - FLOAT",
+		scanner.Slash:   "invalid delimiter between Z coordinate and weight parameter, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between Z coordinate and weight parameter - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between Z coordinate and weight parameter - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid weight parameter, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid weight parameter, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the weight parameter - SPACE",
+		scanner.Unknown: "invalid weight parameter, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the weight parameter - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after weight parameter - WORD",
+		scanner.Integer: "impossible token received when reading the token after weight parameter - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after weight parameter - FLOAT",
+		scanner.Slash:   "invalid token after weight parameter, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after weight parameter - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after weight parameter - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid green component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid green component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the green component - SPACE",
+		scanner.Unknown: "invalid green component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the green component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after green component - WORD",
+		scanner.Integer: "impossible token received when reading the token after green component - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after green component - FLOAT",
+		scanner.Slash:   "invalid token after green component, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter blue component is not specified",
+		scanner.EOF:     "parameter blue component is not specified",
+		scanner.Unknown: "impossible token received when reading the token after green component - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after green component - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid blue component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid blue component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the blue component - SPACE",
+		scanner.EOL:     "parameter blue component is not specified",
+		scanner.EOF:     "parameter blue component is not specified",
+		scanner.Unknown: "invalid blue component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the blue component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after blue component - WORD",
+		scanner.Integer: "impossible token received when reading the token after blue component - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after blue component - FLOAT",
+		scanner.Slash:   "invalid token after blue component, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after blue component - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after blue component - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid blue component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid blue component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the blue component - SPACE",
+		scanner.Unknown: "invalid blue component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the blue component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a vertex - WORD",
+		scanner.Integer: "impossible token received after describing a vertex - INTEGER",
+		scanner.Float:   "impossible token received after describing a vertex - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex - SLASH",
+		scanner.Unknown: "impossible token received after describing a vertex - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a vertex - WORD",
+		scanner.Integer: "unexpected token received after describing a vertex - INTEGER",
+		scanner.Float:   "unexpected token received after describing a vertex - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex - SLASH",
+		scanner.Space:   "impossible token received after describing a vertex - SPACE",
+		scanner.Unknown: "unexpected token received after describing a vertex - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex - UNKNOWN",
+	},
+}
+
+// The scanner modes of the vertex.
+var vertexModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the vertex.
+func newVertexMachine() elementParser {
+	var element = new(types.Vertex)
+	var m = newGeneratedMachine(vertexMatrix, vertexErrors, vertexModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Vertex)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading X coordinate")
+		}
+		element.X = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading Y coordinate")
+		}
+		element.Y = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading Z coordinate")
+		}
+		element.Z = value
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading weight parameter")
+		}
+		element.W = value
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading green component")
+		}
+		element.Color = new(types.Color)
+		element.Color.R, element.Color.G, element.W = element.W, value, 0
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading blue component")
+		}
+		element.Color.B = value
+		return nil
+	}
+	m.actions[15] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading blue component")
+		}
+		element.W, element.Color.R, element.Color.G, element.Color.B = element.Color.R, element.Color.G, element.Color.B, value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the vertex texture.
+var vertexTextureMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 5, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 7, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the vertex texture.
+var vertexTextureErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the vertex texture are not specified",
+		scanner.EOF:     "all parameters of the vertex texture are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid horizontal direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid horizontal direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the horizontal direction - SPACE",
+		scanner.EOL:     "parameter horizontal direction is not specified",
+		scanner.EOF:     "parameter horizontal direction is not specified",
+		scanner.Unknown: "invalid horizontal direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the horizontal direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between horizontal direction and vertical direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between horizontal direction and vertical direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between horizontal direction and vertical direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between horizontal direction and vertical direction, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between horizontal direction and vertical direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between horizontal direction and vertical direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid vertical direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid vertical direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the vertical direction - SPACE",
+		scanner.Unknown: "invalid vertical direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the vertical direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertical direction and depth of the texture - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertical direction and depth of the texture - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertical direction and depth of the texture - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertical direction and depth of the texture, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertical direction and depth of the texture - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertical direction and depth of the texture - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid depth of the texture, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid depth of the texture, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the depth of the texture - SPACE",
+		scanner.Unknown: "invalid depth of the texture, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the depth of the texture - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a vertex texture - WORD",
+		scanner.Integer: "impossible token received after describing a vertex texture - INTEGER",
+		scanner.Float:   "impossible token received after describing a vertex texture - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex texture - SLASH",
+		scanner.Unknown: "impossible token received after describing a vertex texture - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex texture - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a vertex texture - WORD",
+		scanner.Integer: "unexpected token received after describing a vertex texture - INTEGER",
+		scanner.Float:   "unexpected token received after describing a vertex texture - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex texture - SLASH",
+		scanner.Space:   "impossible token received after describing a vertex texture - SPACE",
+		scanner.Unknown: "unexpected token received after describing a vertex texture - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex texture - UNKNOWN",
+	},
+}
+
+// The scanner modes of the vertex texture.
+var vertexTextureModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the vertex texture.
+func newVertexTextureMachine() elementParser {
+	var element = new(types.VertexTexture)
+	var m = newGeneratedMachine(vertexTextureMatrix, vertexTextureErrors, vertexTextureModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.VertexTexture)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading horizontal direction")
+		}
+		element.U = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading vertical direction")
+		}
+		element.V = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading depth of the texture")
+		}
+		element.W = value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the vertex normal.
+var vertexNormalMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 7, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the vertex normal.
+var vertexNormalErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the vertex normal are not specified",
+		scanner.EOF:     "all parameters of the vertex normal are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid i component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid i component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the i component - SPACE",
+		scanner.EOL:     "parameters i component, j component, k component are not specified",
+		scanner.EOF:     "parameters i component, j component, k component are not specified",
+		scanner.Unknown: "invalid i component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the i component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between i component and j component - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between i component and j component - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between i component and j component - FLOAT",
+		scanner.Slash:   "invalid delimiter between i component and j component, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters j component, k component are not specified",
+		scanner.EOF:     "parameters j component, k component are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between i component and j component - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between i component and j component - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid j component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid j component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the j component - SPACE",
+		scanner.EOL:     "parameters j component, k component are not specified",
+		scanner.EOF:     "parameters j component, k component are not specified",
+		scanner.Unknown: "invalid j component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the j component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between j component and k component - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between j component and k component - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between j component and k component - FLOAT",
+		scanner.Slash:   "invalid delimiter between j component and k component, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter k component is not specified",
+		scanner.EOF:     "parameter k component is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between j component and k component - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between j component and k component - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid k component, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid k component, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the k component - SPACE",
+		scanner.EOL:     "parameter k component is not specified",
+		scanner.EOF:     "parameter k component is not specified",
+		scanner.Unknown: "invalid k component, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the k component - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a vertex normal - WORD",
+		scanner.Integer: "impossible token received after describing a vertex normal - INTEGER",
+		scanner.Float:   "impossible token received after describing a vertex normal - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex normal - SLASH",
+		scanner.Unknown: "impossible token received after describing a vertex normal - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex normal - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a vertex normal - WORD",
+		scanner.Integer: "unexpected token received after describing a vertex normal - INTEGER",
+		scanner.Float:   "unexpected token received after describing a vertex normal - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex normal - SLASH",
+		scanner.Space:   "impossible token received after describing a vertex normal - SPACE",
+		scanner.Unknown: "unexpected token received after describing a vertex normal - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex normal - UNKNOWN",
+	},
+}
+
+// The scanner modes of the vertex normal.
+var vertexNormalModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the vertex normal.
+func newVertexNormalMachine() elementParser {
+	var element = new(types.VertexNormal)
+	var m = newGeneratedMachine(vertexNormalMatrix, vertexNormalErrors, vertexNormalModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.VertexNormal)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading i component")
+		}
+		element.I = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading j component")
+		}
+		element.J = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading k component")
+		}
+		element.K = value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the vertex parameter.
+var vertexParameterMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 5, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 7, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the vertex parameter.
+var vertexParameterErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the vertex parameter are not specified",
+		scanner.EOF:     "all parameters of the vertex parameter are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid u coordinate, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid u coordinate, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the u coordinate - SPACE",
+		scanner.EOL:     "parameter u coordinate is not specified",
+		scanner.EOF:     "parameter u coordinate is not specified",
+		scanner.Unknown: "invalid u coordinate, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the u coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between u coordinate and v coordinate - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between u coordinate and v coordinate - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between u coordinate and v coordinate - FLOAT",
+		scanner.Slash:   "invalid delimiter between u coordinate and v coordinate, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between u coordinate and v coordinate - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between u coordinate and v coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid v coordinate, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid v coordinate, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the v coordinate - SPACE",
+		scanner.Unknown: "invalid v coordinate, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the v coordinate - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between v coordinate and weight parameter - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between v coordinate and weight parameter - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between v coordinate and weight parameter - FLOAT",
+		scanner.Slash:   "invalid delimiter between v coordinate and weight parameter, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between v coordinate and weight parameter - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between v coordinate and weight parameter - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid weight parameter, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid weight parameter, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the weight parameter - SPACE",
+		scanner.Unknown: "invalid weight parameter, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the weight parameter - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a vertex parameter - WORD",
+		scanner.Integer: "impossible token received after describing a vertex parameter - INTEGER",
+		scanner.Float:   "impossible token received after describing a vertex parameter - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex parameter - SLASH",
+		scanner.Unknown: "impossible token received after describing a vertex parameter - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex parameter - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a vertex parameter - WORD",
+		scanner.Integer: "unexpected token received after describing a vertex parameter - INTEGER",
+		scanner.Float:   "unexpected token received after describing a vertex parameter - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a vertex parameter - SLASH",
+		scanner.Space:   "impossible token received after describing a vertex parameter - SPACE",
+		scanner.Unknown: "unexpected token received after describing a vertex parameter - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a vertex parameter - UNKNOWN",
+	},
+}
+
+// The scanner modes of the vertex parameter.
+var vertexParameterModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the vertex parameter.
+func newVertexParameterMachine() elementParser {
+	var element = new(types.VertexParameter)
+	var m = newGeneratedMachine(vertexParameterMatrix, vertexParameterErrors, vertexParameterModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.VertexParameter)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading u coordinate")
+		}
+		element.U = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading v coordinate")
+		}
+		element.V = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading weight parameter")
+		}
+		element.W = value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the curve surface type.
+var curveSurfaceTypeMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{5, 1, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the curve surface type.
+var curveSurfaceTypeErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the curve surface type are not specified",
+		scanner.EOF:     "all parameters of the curve surface type are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid curve or surface type, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid curve or surface type, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid curve or surface type, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve or surface type - SPACE",
+		scanner.EOL:     "parameter curve or surface type is not specified",
+		scanner.EOF:     "parameter curve or surface type is not specified",
+		scanner.Unknown: "invalid curve or surface type, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve or surface type - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after curve or surface type - WORD",
+		scanner.Integer: "impossible token received when reading the token after curve or surface type - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after curve or surface type - FLOAT",
+		scanner.Slash:   "invalid token after curve or surface type, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after curve or surface type - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after curve or surface type - COMMENT",
+	},
+	{
+		scanner.Integer: "invalid curve or surface type, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid curve or surface type, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid curve or surface type, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve or surface type - SPACE",
+		scanner.Unknown: "invalid curve or surface type, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve or surface type - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a curve surface type - WORD",
+		scanner.Integer: "impossible token received after describing a curve surface type - INTEGER",
+		scanner.Float:   "impossible token received after describing a curve surface type - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a curve surface type - SLASH",
+		scanner.Unknown: "impossible token received after describing a curve surface type - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a curve surface type - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a curve surface type - WORD",
+		scanner.Integer: "unexpected token received after describing a curve surface type - INTEGER",
+		scanner.Float:   "unexpected token received after describing a curve surface type - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a curve surface type - SLASH",
+		scanner.Space:   "impossible token received after describing a curve surface type - SPACE",
+		scanner.Unknown: "unexpected token received after describing a curve surface type - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a curve surface type - UNKNOWN",
+	},
+}
+
+// The scanner modes of the curve surface type.
+var curveSurfaceTypeModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the curve surface type.
+func newCurveSurfaceTypeMachine() elementParser {
+	var element = new(types.CurveSurfaceType)
+	var m = newGeneratedMachine(curveSurfaceTypeMatrix, curveSurfaceTypeErrors, curveSurfaceTypeModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.CurveSurfaceType)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		if token == "rat" {
+			element.Rational = true
+			return nil
+		}
+		switch token {
+		case "bmatrix":
+			element.Type = 0
+		case "bezier":
+			element.Type = 1
+		case "bspline":
+			element.Type = 2
+		case "cardinal":
+			element.Type = 3
+		case "taylor":
+			element.Type = 4
+		default:
+			return errors.New("the curve or surface type parameter must take the values 'bmatrix', 'bezier', 'bspline', 'cardinal' or 'taylor'")
+		}
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		if !element.Rational {
+			return errors.New("unexpected token received after describing a curve surface type - WORD")
+		}
+		switch token {
+		case "bmatrix":
+			element.Type = 0
+		case "bezier":
+			element.Type = 1
+		case "bspline":
+			element.Type = 2
+		case "cardinal":
+			element.Type = 3
+		case "taylor":
+			element.Type = 4
+		default:
+			return errors.New("the curve or surface type parameter must take the values 'bmatrix', 'bezier', 'bspline', 'cardinal' or 'taylor'")
+		}
+		return nil
+	}
+	m.checks[3] = func() error {
+		if element.Rational {
+			return errors.New("parameter curve or surface type is not specified")
+		}
+		return nil
+	}
+	m.checks[4] = func() error {
+		if element.Rational {
+			return errors.New("parameter curve or surface type is not specified")
+		}
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the degree.
+var degreeMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the degree.
+var degreeErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the degree are not specified",
+		scanner.EOF:     "all parameters of the degree are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid degree in the u direction, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid degree in the u direction, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid degree in the u direction, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the degree in the u direction - SPACE",
+		scanner.EOL:     "parameter degree in the u direction is not specified",
+		scanner.EOF:     "parameter degree in the u direction is not specified",
+		scanner.Unknown: "invalid degree in the u direction, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the degree in the u direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between degree in the u direction and degree in the v direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between degree in the u direction and degree in the v direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between degree in the u direction and degree in the v direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between degree in the u direction and degree in the v direction, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between degree in the u direction and degree in the v direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between degree in the u direction and degree in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid degree in the v direction, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid degree in the v direction, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid degree in the v direction, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the degree in the v direction - SPACE",
+		scanner.Unknown: "invalid degree in the v direction, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the degree in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a degree - WORD",
+		scanner.Integer: "impossible token received after describing a degree - INTEGER",
+		scanner.Float:   "impossible token received after describing a degree - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a degree - SLASH",
+		scanner.Unknown: "impossible token received after describing a degree - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a degree - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a degree - WORD",
+		scanner.Integer: "unexpected token received after describing a degree - INTEGER",
+		scanner.Float:   "unexpected token received after describing a degree - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a degree - SLASH",
+		scanner.Space:   "impossible token received after describing a degree - SPACE",
+		scanner.Unknown: "unexpected token received after describing a degree - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a degree - UNKNOWN",
+	},
+}
+
+// The scanner modes of the degree.
+var degreeModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the degree.
+func newDegreeMachine() elementParser {
+	var element = new(types.Degree)
+	var m = newGeneratedMachine(degreeMatrix, degreeErrors, degreeModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Degree)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading degree in the u direction")
+		}
+		element.U = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading degree in the v direction")
+		}
+		element.V = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the basis matrix.
+var basisMatrixMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 7, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+}
+
+// The error messages of the basis matrix.
+var basisMatrixErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the basis matrix are not specified",
+		scanner.EOF:     "all parameters of the basis matrix are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid direction, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid direction, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid direction, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the direction - SPACE",
+		scanner.EOL:     "parameters direction, matrix parameters are not specified",
+		scanner.EOF:     "parameters direction, matrix parameters are not specified",
+		scanner.Unknown: "invalid direction, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between direction and matrix parameters - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between direction and matrix parameters - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between direction and matrix parameters - FLOAT",
+		scanner.Slash:   "invalid delimiter between direction and matrix parameters, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter matrix parameters is not specified",
+		scanner.EOF:     "parameter matrix parameters is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between direction and matrix parameters - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between direction and matrix parameters - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid matrix, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid matrix number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the matrix number 1 - SPACE",
+		scanner.EOL:     "parameter matrix number 1 is not specified",
+		scanner.EOF:     "parameter matrix number 1 is not specified",
+		scanner.Unknown: "invalid matrix number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the matrix number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after matrix number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after matrix number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after matrix number 1 - FLOAT",
+		scanner.Slash:   "invalid token after matrix number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after matrix number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after matrix number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid matrix, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid additional matrix, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional matrix - SPACE",
+		scanner.Unknown: "invalid additional matrix, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional matrix - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional matrix - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional matrix - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional matrix - FLOAT",
+		scanner.Slash:   "invalid token after additional matrix, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional matrix - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional matrix - COMMENT",
+	},
+}
+
+// The scanner modes of the basis matrix.
+var basisMatrixModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the basis matrix.
+func newBasisMatrixMachine() elementParser {
+	var element = new(types.BasisMatrix)
+	var m = newGeneratedMachine(basisMatrixMatrix, basisMatrixErrors, basisMatrixModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.BasisMatrix)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "v":
+			element.Direction = types.V
+		case "u":
+			element.Direction = types.U
+		default:
+			return errors.New("the direction parameter must take the values 'v' or 'u'")
+		}
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Matrix)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading matrix")
+		}
+		element.Matrix[len(element.Matrix)-1] = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		appendZero(&element.Matrix)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading matrix")
+		}
+		element.Matrix[len(element.Matrix)-1] = value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the step.
+var stepMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the step.
+var stepErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the step are not specified",
+		scanner.EOF:     "all parameters of the step are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid step size in the u direction, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid step size in the u direction, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid step size in the u direction, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the step size in the u direction - SPACE",
+		scanner.EOL:     "parameter step size in the u direction is not specified",
+		scanner.EOF:     "parameter step size in the u direction is not specified",
+		scanner.Unknown: "invalid step size in the u direction, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the step size in the u direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between step size in the u direction and step size in the v direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between step size in the u direction and step size in the v direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between step size in the u direction and step size in the v direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between step size in the u direction and step size in the v direction, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between step size in the u direction and step size in the v direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between step size in the u direction and step size in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid step size in the v direction, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid step size in the v direction, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid step size in the v direction, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the step size in the v direction - SPACE",
+		scanner.Unknown: "invalid step size in the v direction, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the step size in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a step - WORD",
+		scanner.Integer: "impossible token received after describing a step - INTEGER",
+		scanner.Float:   "impossible token received after describing a step - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a step - SLASH",
+		scanner.Unknown: "impossible token received after describing a step - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a step - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a step - WORD",
+		scanner.Integer: "unexpected token received after describing a step - INTEGER",
+		scanner.Float:   "unexpected token received after describing a step - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a step - SLASH",
+		scanner.Space:   "impossible token received after describing a step - SPACE",
+		scanner.Unknown: "unexpected token received after describing a step - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a step - UNKNOWN",
+	},
+}
+
+// The scanner modes of the step.
+var stepModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the step.
+func newStepMachine() elementParser {
+	var element = new(types.Step)
+	var m = newGeneratedMachine(stepMatrix, stepErrors, stepModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Step)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading step size in the u direction")
+		}
+		element.U = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading step size in the v direction")
+		}
+		element.V = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the point.
+var pointMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+}
+
+// The error messages of the point.
+var pointErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the point are not specified",
+		scanner.EOF:     "all parameters of the point are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid vertex, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid vertex, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the vertex number 1 - SPACE",
+		scanner.EOL:     "parameter vertex number 1 is not specified",
+		scanner.EOF:     "parameter vertex number 1 is not specified",
+		scanner.Unknown: "invalid vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 1 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid vertex, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid vertex, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional vertex - SPACE",
+		scanner.Unknown: "invalid additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+}
+
+// The scanner modes of the point.
+var pointModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the point.
+func newPointMachine() elementParser {
+	var element = new(types.Point)
+	var m = newGeneratedMachine(pointMatrix, pointErrors, pointModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Point)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading vertex")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading vertex")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the line.
+var lineMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 4, 14, 1, 1, 1, 1},
+	{1, 5, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 8, 1, 1, 1, 1, 1},
+	{1, 9, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 0, 0, 1, 1},
+	{1, 11, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 12, 1, 1, 1, 1, 1},
+	{1, 13, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 0, 0, 1, 1},
+	{1, 15, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 16, 0, 0, 1, 1},
+	{1, 17, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 16, 0, 0, 1, 1},
+}
+
+// The error messages of the line.
+var lineErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the line are not specified",
+		scanner.EOF:     "all parameters of the line are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 1 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 1, vertex number 2 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 1, vertex number 2 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - FLOAT",
+		scanner.EOL:     "parameter vertex number 2 is not specified",
+		scanner.EOF:     "parameter vertex number 2 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid texture of the vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid texture of the vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 1, vertex number 2 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 1, vertex number 2 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 1 and vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter vertex number 2 is not specified",
+		scanner.EOF:     "parameter vertex number 2 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid texture of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid texture of the vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, vertex number 2 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 2, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional vertex, texture of the additional vertex are not specified",
+		scanner.EOF:     "parameters index of the additional vertex, texture of the additional vertex are not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameter texture of the additional vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional vertex is not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid texture of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid texture of the additional vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter texture of the additional vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional vertex is not specified",
+		scanner.Unknown: "invalid texture of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, vertex number 2 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, vertex number 2 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 2, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameter index of the additional vertex is not specified",
+		scanner.EOF:     "parameter index of the additional vertex is not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+}
+
+// The scanner modes of the line.
+var lineModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the line.
+func newLineMachine() elementParser {
+	var element = new(types.Line)
+	var m = newGeneratedMachine(lineMatrix, lineErrors, lineModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Line)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[15] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[17] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the face.
+var faceMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 4, 55, 1, 1, 1, 1},
+	{1, 5, 1, 38, 1, 1, 1, 1, 1},
+	{1, 1, 1, 6, 26, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 1, 1, 1, 1},
+	{1, 9, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 10, 1, 1, 1, 1, 1},
+	{1, 11, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 12, 1, 1, 1, 1, 1},
+	{1, 13, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 14, 1, 1, 1, 1},
+	{1, 15, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 16, 1, 1, 1, 1, 1},
+	{1, 17, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 18, 1, 1, 1, 1, 1},
+	{1, 19, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 20, 0, 0, 1, 1},
+	{1, 21, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 22, 1, 1, 1, 1, 1},
+	{1, 23, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 24, 1, 1, 1, 1, 1},
+	{1, 25, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 20, 0, 0, 1, 1},
+	{1, 27, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 28, 1, 1, 1, 1, 1},
+	{1, 29, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 30, 1, 1, 1, 1},
+	{1, 31, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 32, 1, 1, 1, 1, 1},
+	{1, 33, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 34, 0, 0, 1, 1},
+	{1, 35, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 36, 1, 1, 1, 1, 1},
+	{1, 37, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 34, 0, 0, 1, 1},
+	{1, 39, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 40, 1, 1, 1, 1},
+	{1, 41, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 42, 1, 1, 1, 1, 1},
+	{1, 1, 1, 43, 1, 1, 1, 1, 1},
+	{1, 44, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 45, 1, 1, 1, 1},
+	{1, 46, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 47, 1, 1, 1, 1, 1},
+	{1, 1, 1, 48, 1, 1, 1, 1, 1},
+	{1, 49, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 50, 0, 0, 1, 1},
+	{1, 51, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 52, 1, 1, 1, 1, 1},
+	{1, 1, 1, 53, 1, 1, 1, 1, 1},
+	{1, 54, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 50, 0, 0, 1, 1},
+	{1, 56, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 57, 1, 1, 1, 1},
+	{1, 58, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 59, 0, 0, 1, 1},
+	{1, 60, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 59, 0, 0, 1, 1},
+}
+
+// The error messages of the face.
+var faceErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the face are not specified",
+		scanner.EOF:     "all parameters of the face are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 1 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - FLOAT",
+		scanner.EOL:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between index of the vertex number 1 and texture of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Space:   "invalid texture of the vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between texture of the vertex number 1 and normal of the vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between texture of the vertex number 1 and normal of the vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between texture of the vertex number 1 and normal of the vertex number 1 - FLOAT",
+		scanner.EOL:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between texture of the vertex number 1 and normal of the vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between texture of the vertex number 1 and normal of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 1 and vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the vertex number 2 - FLOAT",
+		scanner.Space:   "the normal is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 2 and vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter vertex number 3 is not specified",
+		scanner.EOF:     "parameter vertex number 3 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 3, texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 3, texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the vertex number 3 - FLOAT",
+		scanner.Space:   "the normal is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.EOL:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 3, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional vertex, texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.EOF:     "parameters index of the additional vertex, texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the additional vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.Unknown: "invalid texture of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the additional vertex - FLOAT",
+		scanner.Space:   "the normal is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameter normal of the additional vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional vertex is not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the additional vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter normal of the additional vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional vertex is not specified",
+		scanner.Unknown: "invalid normal of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 2 and vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter vertex number 3 is not specified",
+		scanner.EOF:     "parameter vertex number 3 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 3, texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 3, texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid texture of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 3, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional vertex, texture of the additional vertex are not specified",
+		scanner.EOF:     "parameters index of the additional vertex, texture of the additional vertex are not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameter texture of the additional vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional vertex is not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.Space:   "invalid texture of the additional vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter texture of the additional vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional vertex is not specified",
+		scanner.Unknown: "invalid texture of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 1, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 1 and vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 1 and vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 2, normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the vertex number 2, it must be the same as the first vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the vertex number 2, but is not specified for the first vertex",
+		scanner.Float:   "invalid format for description of the vertex number 2, it must be the same as the first vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the vertex number 2, but is specified for the first vertex",
+		scanner.EOL:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid format for description of the vertex number 2, it must be the same as the first vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 2 and vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter vertex number 3 is not specified",
+		scanner.EOF:     "parameter vertex number 3 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 3, texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 3, texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters texture of the vertex number 3, normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the vertex number 3, it must be the same as the first vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the vertex number 3, but is not specified for the first vertex",
+		scanner.Float:   "invalid format for description of the vertex number 3, it must be the same as the first vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the vertex number 3, but is specified for the first vertex",
+		scanner.EOL:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid format for description of the vertex number 3, it must be the same as the first vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters normal of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid normal of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 3, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional vertex, texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.EOF:     "parameters index of the additional vertex, texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional vertex, normal of the additional vertex are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the additional vertex, it must be the same as the first vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the additional vertex, but is not specified for the first vertex",
+		scanner.Float:   "invalid format for description of the additional vertex, it must be the same as the first vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the additional vertex, but is specified for the first vertex",
+		scanner.EOL:     "parameter normal of the additional vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional vertex is not specified",
+		scanner.Unknown: "invalid format for description of the additional vertex, it must be the same as the first vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the additional vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter normal of the additional vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional vertex is not specified",
+		scanner.Unknown: "invalid normal of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 2, vertex number 2, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between vertex number 2 and vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter vertex number 3 is not specified",
+		scanner.EOF:     "parameter vertex number 3 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between vertex number 2 and vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the vertex number 3, vertex number 3 are not specified",
+		scanner.EOF:     "parameters index of the vertex number 3, vertex number 3 are not specified",
+		scanner.Unknown: "invalid index of the vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid token after vertex number 3, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional vertex - SPACE",
+		scanner.EOL:     "parameter index of the additional vertex is not specified",
+		scanner.EOF:     "parameter index of the additional vertex is not specified",
+		scanner.Unknown: "invalid index of the additional vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional vertex - COMMENT",
+	},
+}
+
+// The scanner modes of the face.
+var faceModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the face.
+func newFaceMachine() elementParser {
+	var element = new(types.Face)
+	var m = newGeneratedMachine(faceMatrix, faceErrors, faceModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Face)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[15] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[17] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[19] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[21] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[23] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[25] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[27] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[29] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[31] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[33] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[35] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[37] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[39] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[41] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[44] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[46] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[49] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[51] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[54] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[56] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[58] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[60] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the curve.
+var curveMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 1, 1, 1, 1},
+	{1, 9, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 0, 0, 1, 1},
+	{1, 11, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 10, 0, 0, 1, 1},
+}
+
+// The error messages of the curve.
+var curveErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the curve are not specified",
+		scanner.EOF:     "all parameters of the curve are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value - SPACE",
+		scanner.EOL:     "parameters starting parameter value, ending parameter value, control vertex parameters are not specified",
+		scanner.EOF:     "parameters starting parameter value, ending parameter value, control vertex parameters are not specified",
+		scanner.Unknown: "invalid starting parameter value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value and ending parameter value - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value and ending parameter value - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value and ending parameter value - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value and ending parameter value, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value, control vertex parameters are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value and ending parameter value - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value and ending parameter value - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value - SPACE",
+		scanner.EOL:     "parameters ending parameter value, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value, control vertex parameters are not specified",
+		scanner.Unknown: "invalid ending parameter value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value and control vertex parameters - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value and control vertex parameters - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value and control vertex parameters - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value and control vertex parameters, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex parameters is not specified",
+		scanner.EOF:     "parameter control vertex parameters is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value and control vertex parameters - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value and control vertex parameters - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid control vertex, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control vertex, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid control vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the control vertex number 1 - SPACE",
+		scanner.EOL:     "parameters control vertex number 1, control vertex number 2 are not specified",
+		scanner.EOF:     "parameters control vertex number 1, control vertex number 2 are not specified",
+		scanner.Unknown: "invalid control vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 1 and control vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex number 2 is not specified",
+		scanner.EOF:     "parameter control vertex number 2 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid control vertex, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control vertex, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the control vertex number 2 - SPACE",
+		scanner.EOL:     "parameter control vertex number 2 is not specified",
+		scanner.EOF:     "parameter control vertex number 2 is not specified",
+		scanner.Unknown: "invalid control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid token after control vertex number 2, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid control vertex, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control vertex, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional control vertex - SPACE",
+		scanner.Unknown: "invalid additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional control vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control vertex - COMMENT",
+	},
+}
+
+// The scanner modes of the curve.
+var curveModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the curve.
+func newCurveMachine() elementParser {
+	var element = new(types.Curve)
+	var m = newGeneratedMachine(curveMatrix, curveErrors, curveModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Curve)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Start = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.End = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control vertex")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control vertex")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control vertex")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the curve 2D.
+var curve2DMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+}
+
+// The error messages of the curve 2D.
+var curve2DErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the curve 2D are not specified",
+		scanner.EOF:     "all parameters of the curve 2D are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid control point, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control point, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid control point number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the control point number 1 - SPACE",
+		scanner.EOL:     "parameters control point number 1, control point number 2 are not specified",
+		scanner.EOF:     "parameters control point number 1, control point number 2 are not specified",
+		scanner.Unknown: "invalid control point number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the control point number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control point number 1 and control point number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control point number 1 and control point number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control point number 1 and control point number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control point number 1 and control point number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control point number 2 is not specified",
+		scanner.EOF:     "parameter control point number 2 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control point number 1 and control point number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control point number 1 and control point number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid control point, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control point, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid control point number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the control point number 2 - SPACE",
+		scanner.EOL:     "parameter control point number 2 is not specified",
+		scanner.EOF:     "parameter control point number 2 is not specified",
+		scanner.Unknown: "invalid control point number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the control point number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control point number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control point number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control point number 2 - FLOAT",
+		scanner.Slash:   "invalid token after control point number 2, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control point number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control point number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid control point, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid control point, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid additional control point, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional control point - SPACE",
+		scanner.Unknown: "invalid additional control point, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional control point - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control point - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control point - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control point - FLOAT",
+		scanner.Slash:   "invalid token after additional control point, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control point - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control point - COMMENT",
+	},
+}
+
+// The scanner modes of the curve 2D.
+var curve2DModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the curve 2D.
+func newCurve2DMachine() elementParser {
+	var element = new(types.Curve2D)
+	var m = newGeneratedMachine(curve2DMatrix, curve2DErrors, curve2DModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Curve2D)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control point")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control point")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading control point")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the surface.
+var surfaceMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 7, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 1, 1, 1, 1},
+	{1, 9, 9, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 1, 1, 1, 1},
+	{1, 11, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 12, 78, 1, 1, 1, 1},
+	{1, 13, 1, 56, 1, 1, 1, 1, 1},
+	{1, 1, 1, 14, 40, 1, 1, 1, 1},
+	{1, 15, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 16, 1, 1, 1, 1},
+	{1, 17, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 18, 1, 1, 1, 1, 1},
+	{1, 19, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 20, 1, 1, 1, 1, 1},
+	{1, 21, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 22, 1, 1, 1, 1},
+	{1, 23, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 24, 1, 1, 1, 1, 1},
+	{1, 25, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 26, 1, 1, 1, 1, 1},
+	{1, 27, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 28, 1, 1, 1, 1},
+	{1, 29, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 30, 1, 1, 1, 1, 1},
+	{1, 31, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 32, 1, 1, 1, 1, 1},
+	{1, 33, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 34, 0, 0, 1, 1},
+	{1, 35, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 36, 1, 1, 1, 1, 1},
+	{1, 37, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 38, 1, 1, 1, 1, 1},
+	{1, 39, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 34, 0, 0, 1, 1},
+	{1, 41, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 42, 1, 1, 1, 1, 1},
+	{1, 43, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 44, 1, 1, 1, 1},
+	{1, 45, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 46, 1, 1, 1, 1, 1},
+	{1, 47, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 48, 1, 1, 1, 1},
+	{1, 49, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 50, 1, 1, 1, 1, 1},
+	{1, 51, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 52, 0, 0, 1, 1},
+	{1, 53, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 54, 1, 1, 1, 1, 1},
+	{1, 55, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 52, 0, 0, 1, 1},
+	{1, 57, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 58, 1, 1, 1, 1},
+	{1, 59, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 60, 1, 1, 1, 1, 1},
+	{1, 1, 1, 61, 1, 1, 1, 1, 1},
+	{1, 62, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 63, 1, 1, 1, 1},
+	{1, 64, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 65, 1, 1, 1, 1, 1},
+	{1, 1, 1, 66, 1, 1, 1, 1, 1},
+	{1, 67, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 68, 1, 1, 1, 1},
+	{1, 69, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 70, 1, 1, 1, 1, 1},
+	{1, 1, 1, 71, 1, 1, 1, 1, 1},
+	{1, 72, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 73, 0, 0, 1, 1},
+	{1, 74, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 75, 1, 1, 1, 1, 1},
+	{1, 1, 1, 76, 1, 1, 1, 1, 1},
+	{1, 77, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 73, 0, 0, 1, 1},
+	{1, 79, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 80, 1, 1, 1, 1},
+	{1, 81, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 82, 1, 1, 1, 1},
+	{1, 83, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 84, 0, 0, 1, 1},
+	{1, 85, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 84, 0, 0, 1, 1},
+}
+
+// The error messages of the surface.
+var surfaceErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the surface are not specified",
+		scanner.EOF:     "all parameters of the surface are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value in the u direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value in the u direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value in the u direction - SPACE",
+		scanner.EOL:     "parameters starting parameter value in the u direction, ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters starting parameter value in the u direction, ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "invalid starting parameter value in the u direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value in the u direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value in the u direction and ending parameter value in the u direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value in the u direction and ending parameter value in the u direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value in the u direction and ending parameter value in the u direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value in the u direction and ending parameter value in the u direction, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value in the u direction and ending parameter value in the u direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value in the u direction and ending parameter value in the u direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value in the u direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value in the u direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value in the u direction - SPACE",
+		scanner.EOL:     "parameters ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value in the u direction, starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "invalid ending parameter value in the u direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value in the u direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value in the u direction and starting parameter value in the v direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value in the u direction and starting parameter value in the v direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value in the u direction and starting parameter value in the v direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value in the u direction and starting parameter value in the v direction, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value in the u direction and starting parameter value in the v direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value in the u direction and starting parameter value in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value in the v direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value in the v direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value in the v direction - SPACE",
+		scanner.EOL:     "parameters starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters starting parameter value in the v direction, ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "invalid starting parameter value in the v direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value in the v direction and ending parameter value in the v direction - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value in the v direction and ending parameter value in the v direction - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value in the v direction and ending parameter value in the v direction - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value in the v direction and ending parameter value in the v direction, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value in the v direction and ending parameter value in the v direction - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value in the v direction and ending parameter value in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value in the v direction, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value in the v direction, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value in the v direction - SPACE",
+		scanner.EOL:     "parameters ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.EOF:     "parameters ending parameter value in the v direction, control vertex parameters are not specified",
+		scanner.Unknown: "invalid ending parameter value in the v direction, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value in the v direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value in the v direction and control vertex parameters - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value in the v direction and control vertex parameters - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value in the v direction and control vertex parameters - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value in the v direction and control vertex parameters, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex parameters is not specified",
+		scanner.EOF:     "parameter control vertex parameters is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value in the v direction and control vertex parameters - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value in the v direction and control vertex parameters - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 1 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between index of the control vertex number 1 and texture of the control vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between index of the control vertex number 1 and texture of the control vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between index of the control vertex number 1 and texture of the control vertex number 1 - FLOAT",
+		scanner.EOL:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between index of the control vertex number 1 and texture of the control vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between index of the control vertex number 1 and texture of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Space:   "invalid texture of the control vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between texture of the control vertex number 1 and normal of the control vertex number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between texture of the control vertex number 1 and normal of the control vertex number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between texture of the control vertex number 1 and normal of the control vertex number 1 - FLOAT",
+		scanner.EOL:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between texture of the control vertex number 1 and normal of the control vertex number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between texture of the control vertex number 1 and normal of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 1 and control vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 2, texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 2, texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the control vertex number 2 - FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 2 and control vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 3, texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 3, texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the control vertex number 3 - FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 3 and control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex number 4 is not specified",
+		scanner.EOF:     "parameter control vertex number 4 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 4 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 4, texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 4, texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 4 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 4, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the control vertex number 4 - FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 4, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid token after control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional control vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional control vertex, texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.EOF:     "parameters index of the additional control vertex, texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.Unknown: "invalid index of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional control vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the additional control vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.Unknown: "invalid texture of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after texture of the additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after texture of the additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after texture of the additional control vertex - FLOAT",
+		scanner.Space:   "the normal is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.EOL:     "parameter normal of the additional control vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional control vertex is not specified",
+		scanner.Unknown: "impossible token received when reading the token after texture of the additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after texture of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the additional control vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter normal of the additional control vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional control vertex is not specified",
+		scanner.Unknown: "invalid normal of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional control vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 2, texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 2, texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 2 and control vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 3, texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 3, texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 3 and control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex number 4 is not specified",
+		scanner.EOF:     "parameter control vertex number 4 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 4 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 4, texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 4, texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 4 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the control vertex number 4, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid texture of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid token after control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional control vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional control vertex, texture of the additional control vertex are not specified",
+		scanner.EOF:     "parameters index of the additional control vertex, texture of the additional control vertex are not specified",
+		scanner.Unknown: "invalid index of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional control vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.EOL:     "parameter texture of the additional control vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional control vertex is not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid texture, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid texture, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "the texture is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.Space:   "invalid texture of the additional control vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter texture of the additional control vertex is not specified",
+		scanner.EOF:     "parameter texture of the additional control vertex is not specified",
+		scanner.Unknown: "invalid texture of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional control vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 1, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 1, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 1 and control vertex number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 1 and control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 2, texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 2, texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 2 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 2, normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the control vertex number 2, it must be the same as the first control vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the control vertex number 2, but is not specified for the first control vertex",
+		scanner.Float:   "invalid format for description of the control vertex number 2, it must be the same as the first control vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 2, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid format for description of the control vertex number 2, it must be the same as the first control vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 2, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 2 and control vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 3, texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 3, texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 3 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 3, normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the control vertex number 3, it must be the same as the first control vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the control vertex number 3, but is not specified for the first control vertex",
+		scanner.Float:   "invalid format for description of the control vertex number 3, it must be the same as the first control vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 3, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid format for description of the control vertex number 3, it must be the same as the first control vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 3, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 3 and control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex number 4 is not specified",
+		scanner.EOF:     "parameter control vertex number 4 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 4 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 4, texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 4, texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the control vertex number 4 - FLOAT",
+		scanner.Space:   "the texture is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters texture of the control vertex number 4, normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the control vertex number 4, it must be the same as the first control vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the control vertex number 4, but is not specified for the first control vertex",
+		scanner.Float:   "invalid format for description of the control vertex number 4, it must be the same as the first control vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the control vertex number 4, but is specified for the first control vertex",
+		scanner.EOL:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid format for description of the control vertex number 4, it must be the same as the first control vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the control vertex number 4, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters normal of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid normal of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid token after control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional control vertex - SPACE",
+		scanner.EOL:     "parameters index of the additional control vertex, texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.EOF:     "parameters index of the additional control vertex, texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.Unknown: "invalid index of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after index of the additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after index of the additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after index of the additional control vertex - FLOAT",
+		scanner.Space:   "the texture is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.EOL:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.EOF:     "parameters texture of the additional control vertex, normal of the additional control vertex are not specified",
+		scanner.Unknown: "impossible token received when reading the token after index of the additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid format for description of the additional control vertex, it must be the same as the first control vertex, expected: SLASH, received: WORD",
+		scanner.Integer: "the texture is specified for the additional control vertex, but is not specified for the first control vertex",
+		scanner.Float:   "invalid format for description of the additional control vertex, it must be the same as the first control vertex, expected: SLASH, received: FLOAT",
+		scanner.Space:   "the normal is not specified for the additional control vertex, but is specified for the first control vertex",
+		scanner.EOL:     "parameter normal of the additional control vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional control vertex is not specified",
+		scanner.Unknown: "invalid format for description of the additional control vertex, it must be the same as the first control vertex, expected: SLASH, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the texture of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid normal, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid normal, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid normal of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "invalid normal of the additional control vertex, expected: INTEGER, received: SPACE",
+		scanner.EOL:     "parameter normal of the additional control vertex is not specified",
+		scanner.EOF:     "parameter normal of the additional control vertex is not specified",
+		scanner.Unknown: "invalid normal of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the normal of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional control vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 2, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 2 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 2, control vertex number 2, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 2, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 2 and control vertex number 3, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 2 and control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 3, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 3 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 3, control vertex number 3, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 3, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 3 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid delimiter between control vertex number 3 and control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter control vertex number 4 is not specified",
+		scanner.EOF:     "parameter control vertex number 4 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between control vertex number 3 and control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the control vertex number 4, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the control vertex number 4 - SPACE",
+		scanner.EOL:     "parameters index of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.EOF:     "parameters index of the control vertex number 4, control vertex number 4 are not specified",
+		scanner.Unknown: "invalid index of the control vertex number 4, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after control vertex number 4 - WORD",
+		scanner.Integer: "impossible token received when reading the token after control vertex number 4 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after control vertex number 4 - FLOAT",
+		scanner.Slash:   "invalid token after control vertex number 4, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after control vertex number 4 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after control vertex number 4 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid index of the additional control vertex, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the index of the additional control vertex - SPACE",
+		scanner.EOL:     "parameter index of the additional control vertex is not specified",
+		scanner.EOF:     "parameter index of the additional control vertex is not specified",
+		scanner.Unknown: "invalid index of the additional control vertex, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the index of the additional control vertex - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional control vertex - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional control vertex - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional control vertex - FLOAT",
+		scanner.Slash:   "invalid token after additional control vertex, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional control vertex - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional control vertex - COMMENT",
+	},
+}
+
+// The scanner modes of the surface.
+var surfaceModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the surface.
+func newSurfaceMachine() elementParser {
+	var element = new(types.Surface)
+	var m = newGeneratedMachine(surfaceMatrix, surfaceErrors, surfaceModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Surface)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value in the u direction")
+		}
+		element.StartU = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value in the u direction")
+		}
+		element.EndU = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value in the v direction")
+		}
+		element.StartV = value
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value in the v direction")
+		}
+		element.EndV = value
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[15] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[17] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[19] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[21] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[23] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[25] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[27] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[29] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[31] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[33] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[35] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[37] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[39] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[41] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[43] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[45] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[47] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[49] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[51] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[53] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[55] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading texture")
+		}
+		element.Vertices[len(element.Vertices)-1].Texture = int(value)
+		return nil
+	}
+	m.actions[57] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[59] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[62] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[64] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[67] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[69] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[72] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[74] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[77] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading normal")
+		}
+		element.Vertices[len(element.Vertices)-1].Normal = int(value)
+		return nil
+	}
+	m.actions[79] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[81] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[83] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.actions[85] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading index")
+		}
+		element.Vertices[len(element.Vertices)-1].Index = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the parameter.
+var parameterMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 7, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 9, 9, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+}
+
+// The error messages of the parameter.
+var parameterErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the parameter are not specified",
+		scanner.EOF:     "all parameters of the parameter are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid direction, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid direction, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid direction, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the direction - SPACE",
+		scanner.EOL:     "parameters direction, parameter value parameters are not specified",
+		scanner.EOF:     "parameters direction, parameter value parameters are not specified",
+		scanner.Unknown: "invalid direction, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the direction - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between direction and parameter value parameters - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between direction and parameter value parameters - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between direction and parameter value parameters - FLOAT",
+		scanner.Slash:   "invalid delimiter between direction and parameter value parameters, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter parameter value parameters is not specified",
+		scanner.EOF:     "parameter parameter value parameters is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between direction and parameter value parameters - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between direction and parameter value parameters - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid parameter value number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the parameter value number 1 - SPACE",
+		scanner.EOL:     "parameters parameter value number 1, parameter value number 2 are not specified",
+		scanner.EOF:     "parameters parameter value number 1, parameter value number 2 are not specified",
+		scanner.Unknown: "invalid parameter value number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the parameter value number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between parameter value number 1 and parameter value number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between parameter value number 1 and parameter value number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between parameter value number 1 and parameter value number 2 - FLOAT",
+		scanner.Slash:   "invalid delimiter between parameter value number 1 and parameter value number 2, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter parameter value number 2 is not specified",
+		scanner.EOF:     "parameter parameter value number 2 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between parameter value number 1 and parameter value number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between parameter value number 1 and parameter value number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid parameter value number 2, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the parameter value number 2 - SPACE",
+		scanner.EOL:     "parameter parameter value number 2 is not specified",
+		scanner.EOF:     "parameter parameter value number 2 is not specified",
+		scanner.Unknown: "invalid parameter value number 2, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the parameter value number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after parameter value number 2 - WORD",
+		scanner.Integer: "impossible token received when reading the token after parameter value number 2 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after parameter value number 2 - FLOAT",
+		scanner.Slash:   "invalid token after parameter value number 2, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after parameter value number 2 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after parameter value number 2 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid additional parameter value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional parameter value - SPACE",
+		scanner.Unknown: "invalid additional parameter value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional parameter value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional parameter value - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional parameter value - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional parameter value - FLOAT",
+		scanner.Slash:   "invalid token after additional parameter value, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional parameter value - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional parameter value - COMMENT",
+	},
+}
+
+// The scanner modes of the parameter.
+var parameterModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the parameter.
+func newParameterMachine() elementParser {
+	var element = new(types.Parameter)
+	var m = newGeneratedMachine(parameterMatrix, parameterErrors, parameterModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Parameter)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "v":
+			element.Direction = types.V
+		case "u":
+			element.Direction = types.U
+		default:
+			return errors.New("the direction parameter must take the values 'v' or 'u'")
+		}
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Values)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading parameter value")
+		}
+		element.Values[len(element.Values)-1] = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		appendZero(&element.Values)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading parameter value")
+		}
+		element.Values[len(element.Values)-1] = value
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Values)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading parameter value")
+		}
+		element.Values[len(element.Values)-1] = value
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the trim.
+var trimMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 9, 9, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 1, 1, 1, 1},
+	{1, 11, 11, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 12, 1, 1, 1, 1},
+	{1, 13, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+}
+
+// The error messages of the trim.
+var trimErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the trim are not specified",
+		scanner.EOF:     "all parameters of the trim are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the curve segment number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "invalid curve index of the curve segment number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid token after curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the additional curve segment, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the additional curve segment - SPACE",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "invalid curve index of the additional curve segment, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional curve segment - FLOAT",
+		scanner.Slash:   "invalid token after additional curve segment, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional curve segment - COMMENT",
+	},
+}
+
+// The scanner modes of the trim.
+var trimModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the trim.
+func newTrimMachine() elementParser {
+	var element = new(types.Trim)
+	var m = newGeneratedMachine(trimMatrix, trimErrors, trimModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Trim)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the hole.
+var holeMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 9, 9, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 1, 1, 1, 1},
+	{1, 11, 11, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 12, 1, 1, 1, 1},
+	{1, 13, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+}
+
+// The error messages of the hole.
+var holeErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the hole are not specified",
+		scanner.EOF:     "all parameters of the hole are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the curve segment number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "invalid curve index of the curve segment number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid token after curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the additional curve segment, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the additional curve segment - SPACE",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "invalid curve index of the additional curve segment, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional curve segment - FLOAT",
+		scanner.Slash:   "invalid token after additional curve segment, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional curve segment - COMMENT",
+	},
+}
+
+// The scanner modes of the hole.
+var holeModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the hole.
+func newHoleMachine() elementParser {
+	var element = new(types.Hole)
+	var m = newGeneratedMachine(holeMatrix, holeErrors, holeModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Hole)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the special curve.
+var specialCurveMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 3, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 9, 9, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 1, 1, 1, 1},
+	{1, 11, 11, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 12, 1, 1, 1, 1},
+	{1, 13, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+}
+
+// The error messages of the special curve.
+var specialCurveErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the special curve are not specified",
+		scanner.EOF:     "all parameters of the special curve are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters starting parameter value of the curve segment number 1, ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid starting parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the curve segment number 1 and ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.EOF:     "parameters ending parameter value of the curve segment number 1, curve index of the curve segment number 1 are not specified",
+		scanner.Unknown: "invalid ending parameter value of the curve segment number 1, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the curve segment number 1 and curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the curve segment number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the curve segment number 1 - SPACE",
+		scanner.EOL:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.EOF:     "parameter curve index of the curve segment number 1 is not specified",
+		scanner.Unknown: "invalid curve index of the curve segment number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after curve segment number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after curve segment number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after curve segment number 1 - FLOAT",
+		scanner.Slash:   "invalid token after curve segment number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after curve segment number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after curve segment number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters starting parameter value of the additional curve segment, ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid starting parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the additional curve segment and ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the additional curve segment - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.EOF:     "parameters ending parameter value of the additional curve segment, curve index of the additional curve segment are not specified",
+		scanner.Unknown: "invalid ending parameter value of the additional curve segment, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the additional curve segment and curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid curve index of the additional curve segment, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the curve index of the additional curve segment - SPACE",
+		scanner.EOL:     "parameter curve index of the additional curve segment is not specified",
+		scanner.EOF:     "parameter curve index of the additional curve segment is not specified",
+		scanner.Unknown: "invalid curve index of the additional curve segment, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the curve index of the additional curve segment - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional curve segment - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional curve segment - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional curve segment - FLOAT",
+		scanner.Slash:   "invalid token after additional curve segment, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional curve segment - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional curve segment - COMMENT",
+	},
+}
+
+// The scanner modes of the special curve.
+var specialCurveModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the special curve.
+func newSpecialCurveMachine() elementParser {
+	var element = new(types.SpecialCurve)
+	var m = newGeneratedMachine(specialCurveMatrix, specialCurveErrors, specialCurveModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.SpecialCurve)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		appendZero(&element.Segments)
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value")
+		}
+		element.Segments[len(element.Segments)-1].Start = value
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value")
+		}
+		element.Segments[len(element.Segments)-1].End = value
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading curve index")
+		}
+		element.Segments[len(element.Segments)-1].Curve = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the special point.
+var specialPointMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+}
+
+// The error messages of the special point.
+var specialPointErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the special point are not specified",
+		scanner.EOF:     "all parameters of the special point are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid point, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid point, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid point number 1, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the point number 1 - SPACE",
+		scanner.EOL:     "parameter point number 1 is not specified",
+		scanner.EOF:     "parameter point number 1 is not specified",
+		scanner.Unknown: "invalid point number 1, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the point number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after point number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after point number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after point number 1 - FLOAT",
+		scanner.Slash:   "invalid token after point number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after point number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after point number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid point, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid point, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid additional point, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional point - SPACE",
+		scanner.Unknown: "invalid additional point, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional point - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional point - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional point - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional point - FLOAT",
+		scanner.Slash:   "invalid token after additional point, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional point - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional point - COMMENT",
+	},
+}
+
+// The scanner modes of the special point.
+var specialPointModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the special point.
+func newSpecialPointMachine() elementParser {
+	var element = new(types.SpecialPoint)
+	var m = newGeneratedMachine(specialPointMatrix, specialPointErrors, specialPointModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.SpecialPoint)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading point")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Vertices)
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading point")
+		}
+		element.Vertices[len(element.Vertices)-1] = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the end.
+var endMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the end.
+var endErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a end - WORD",
+		scanner.Integer: "unexpected token received after describing a end - INTEGER",
+		scanner.Float:   "unexpected token received after describing a end - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a end - SLASH",
+		scanner.Space:   "impossible token received after describing a end - SPACE",
+		scanner.Unknown: "unexpected token received after describing a end - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a end - UNKNOWN",
+	},
+}
+
+// The scanner modes of the end.
+var endModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the end.
+func newEndMachine() elementParser {
+	var element = new(types.End)
+	var m = newGeneratedMachine(endMatrix, endErrors, endModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.End)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the connect.
+var connectMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 1, 1, 1, 1},
+	{1, 7, 7, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 8, 1, 1, 1, 1},
+	{1, 9, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 10, 1, 1, 1, 1},
+	{1, 11, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 12, 1, 1, 1, 1},
+	{1, 13, 13, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 14, 1, 1, 1, 1},
+	{1, 15, 15, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 16, 1, 1, 1, 1},
+	{1, 17, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 18, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the connect.
+var connectErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the connect are not specified",
+		scanner.EOF:     "all parameters of the connect are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Word:    "invalid first surface index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid first surface index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid first surface index, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the first surface index - SPACE",
+		scanner.EOL:     "parameters first surface index, starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters first surface index, starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid first surface index, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the first surface index - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between first surface index and starting parameter value of the first curve - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between first surface index and starting parameter value of the first curve - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between first surface index and starting parameter value of the first curve - FLOAT",
+		scanner.Slash:   "invalid delimiter between first surface index and starting parameter value of the first curve, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between first surface index and starting parameter value of the first curve - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between first surface index and starting parameter value of the first curve - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value of the first curve, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the first curve, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the first curve - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters starting parameter value of the first curve, ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid starting parameter value of the first curve, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the first curve - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the first curve and ending parameter value of the first curve - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the first curve and ending parameter value of the first curve - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the first curve and ending parameter value of the first curve - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the first curve and ending parameter value of the first curve, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the first curve and ending parameter value of the first curve - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the first curve and ending parameter value of the first curve - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value of the first curve, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the first curve, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the first curve - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters ending parameter value of the first curve, first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid ending parameter value of the first curve, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the first curve - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the first curve and first curve index - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the first curve and first curve index - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the first curve and first curve index - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the first curve and first curve index, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the first curve and first curve index - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the first curve and first curve index - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid first curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid first curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid first curve index, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the first curve index - SPACE",
+		scanner.EOL:     "parameters first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters first curve index, second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid first curve index, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the first curve index - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between first curve index and second surface index - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between first curve index and second surface index - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between first curve index and second surface index - FLOAT",
+		scanner.Slash:   "invalid delimiter between first curve index and second surface index, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between first curve index and second surface index - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between first curve index and second surface index - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid second surface index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid second surface index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid second surface index, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the second surface index - SPACE",
+		scanner.EOL:     "parameters second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters second surface index, starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid second surface index, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the second surface index - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between second surface index and starting parameter value of the second curve - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between second surface index and starting parameter value of the second curve - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between second surface index and starting parameter value of the second curve - FLOAT",
+		scanner.Slash:   "invalid delimiter between second surface index and starting parameter value of the second curve, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between second surface index and starting parameter value of the second curve - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between second surface index and starting parameter value of the second curve - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid starting parameter value of the second curve, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid starting parameter value of the second curve, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the starting parameter value of the second curve - SPACE",
+		scanner.EOL:     "parameters starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters starting parameter value of the second curve, ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid starting parameter value of the second curve, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the starting parameter value of the second curve - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between starting parameter value of the second curve and ending parameter value of the second curve - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between starting parameter value of the second curve and ending parameter value of the second curve - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between starting parameter value of the second curve and ending parameter value of the second curve - FLOAT",
+		scanner.Slash:   "invalid delimiter between starting parameter value of the second curve and ending parameter value of the second curve, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameters ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between starting parameter value of the second curve and ending parameter value of the second curve - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between starting parameter value of the second curve and ending parameter value of the second curve - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid ending parameter value of the second curve, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid ending parameter value of the second curve, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the ending parameter value of the second curve - SPACE",
+		scanner.EOL:     "parameters ending parameter value of the second curve, second curve index are not specified",
+		scanner.EOF:     "parameters ending parameter value of the second curve, second curve index are not specified",
+		scanner.Unknown: "invalid ending parameter value of the second curve, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the ending parameter value of the second curve - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between ending parameter value of the second curve and second curve index - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between ending parameter value of the second curve and second curve index - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between ending parameter value of the second curve and second curve index - FLOAT",
+		scanner.Slash:   "invalid delimiter between ending parameter value of the second curve and second curve index, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter second curve index is not specified",
+		scanner.EOF:     "parameter second curve index is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between ending parameter value of the second curve and second curve index - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between ending parameter value of the second curve and second curve index - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid second curve index, expected: INTEGER, received: WORD",
+		scanner.Float:   "invalid second curve index, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid second curve index, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the second curve index - SPACE",
+		scanner.EOL:     "parameter second curve index is not specified",
+		scanner.EOF:     "parameter second curve index is not specified",
+		scanner.Unknown: "invalid second curve index, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the second curve index - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a connect - WORD",
+		scanner.Integer: "impossible token received after describing a connect - INTEGER",
+		scanner.Float:   "impossible token received after describing a connect - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a connect - SLASH",
+		scanner.Unknown: "impossible token received after describing a connect - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a connect - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a connect - WORD",
+		scanner.Integer: "unexpected token received after describing a connect - INTEGER",
+		scanner.Float:   "unexpected token received after describing a connect - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a connect - SLASH",
+		scanner.Space:   "impossible token received after describing a connect - SPACE",
+		scanner.Unknown: "unexpected token received after describing a connect - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a connect - UNKNOWN",
+	},
+}
+
+// The scanner modes of the connect.
+var connectModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the connect.
+func newConnectMachine() elementParser {
+	var element = new(types.Connect)
+	var m = newGeneratedMachine(connectMatrix, connectErrors, connectModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Connect)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading first surface index")
+		}
+		element.Surface1 = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value of the first curve")
+		}
+		element.Start1 = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value of the first curve")
+		}
+		element.End1 = value
+		return nil
+	}
+	m.actions[9] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading first curve index")
+		}
+		element.Curve1 = int(value)
+		return nil
+	}
+	m.actions[11] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading second surface index")
+		}
+		element.Surface2 = int(value)
+		return nil
+	}
+	m.actions[13] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading starting parameter value of the second curve")
+		}
+		element.Start2 = value
+		return nil
+	}
+	m.actions[15] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading ending parameter value of the second curve")
+		}
+		element.End2 = value
+		return nil
+	}
+	m.actions[17] = func(token string) error {
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading second curve index")
+		}
+		element.Curve2 = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the group.
+var groupMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{5, 1, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+}
+
+// The error messages of the group.
+var groupErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the group are not specified",
+		scanner.EOF:     "all parameters of the group are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid group name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid group name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid group name number 1, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the group name number 1 - SPACE",
+		scanner.EOL:     "parameter group name number 1 is not specified",
+		scanner.EOF:     "parameter group name number 1 is not specified",
+		scanner.Unknown: "invalid group name number 1, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the group name number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after group name number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after group name number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after group name number 1 - FLOAT",
+		scanner.Slash:   "invalid token after group name number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after group name number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after group name number 1 - COMMENT",
+	},
+	{
+		scanner.Integer: "invalid group name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid group name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid additional group name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional group name - SPACE",
+		scanner.Unknown: "invalid additional group name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional group name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional group name - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional group name - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional group name - FLOAT",
+		scanner.Slash:   "invalid token after additional group name, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional group name - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional group name - COMMENT",
+	},
+}
+
+// The scanner modes of the group.
+var groupModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Names, scanner.Tokens}
+
+// Creates a new elementParser of the group.
+func newGroupMachine() elementParser {
+	var element = new(types.Group)
+	var m = newGeneratedMachine(groupMatrix, groupErrors, groupModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Group)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Names)
+		element.Names[len(element.Names)-1] = token
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Names)
+		element.Names[len(element.Names)-1] = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the smoothing group.
+var smoothingGroupMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the smoothing group.
+var smoothingGroupErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the smoothing group are not specified",
+		scanner.EOF:     "all parameters of the smoothing group are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Float:   "invalid group number, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid group number, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the group number - SPACE",
+		scanner.EOL:     "parameter group number is not specified",
+		scanner.EOF:     "parameter group number is not specified",
+		scanner.Unknown: "invalid group number, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the group number - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a smoothing group - WORD",
+		scanner.Integer: "impossible token received after describing a smoothing group - INTEGER",
+		scanner.Float:   "impossible token received after describing a smoothing group - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a smoothing group - SLASH",
+		scanner.Unknown: "impossible token received after describing a smoothing group - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a smoothing group - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a smoothing group - WORD",
+		scanner.Integer: "unexpected token received after describing a smoothing group - INTEGER",
+		scanner.Float:   "unexpected token received after describing a smoothing group - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a smoothing group - SLASH",
+		scanner.Space:   "impossible token received after describing a smoothing group - SPACE",
+		scanner.Unknown: "unexpected token received after describing a smoothing group - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a smoothing group - UNKNOWN",
+	},
+}
+
+// The scanner modes of the smoothing group.
+var smoothingGroupModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the smoothing group.
+func newSmoothingGroupMachine() elementParser {
+	var element = new(types.SmoothingGroup)
+	var m = newGeneratedMachine(smoothingGroupMatrix, smoothingGroupErrors, smoothingGroupModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.SmoothingGroup)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "off":
+			element.Number = 0
+			return nil
+		}
+		if _, err := strconv.Atoi(token); err != nil {
+			return errors.New("the group number parameter must be an integer or take the values 'off'")
+		}
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading group number")
+		}
+		element.Number = int(value)
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the merging group.
+var mergingGroupMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 3, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 5, 5, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the merging group.
+var mergingGroupErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the merging group are not specified",
+		scanner.EOF:     "all parameters of the merging group are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Float:   "invalid group number, expected: INTEGER, received: FLOAT",
+		scanner.Slash:   "invalid group number, expected: INTEGER, received: SLASH",
+		scanner.Space:   "impossible token received when reading the group number - SPACE",
+		scanner.EOL:     "parameter group number is not specified",
+		scanner.EOF:     "parameter group number is not specified",
+		scanner.Unknown: "invalid group number, expected: INTEGER, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the group number - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between group number and resolution - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between group number and resolution - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between group number and resolution - FLOAT",
+		scanner.Slash:   "invalid delimiter between group number and resolution, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between group number and resolution - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between group number and resolution - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid resolution, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid resolution, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the resolution - SPACE",
+		scanner.Unknown: "invalid resolution, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the resolution - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a merging group - WORD",
+		scanner.Integer: "impossible token received after describing a merging group - INTEGER",
+		scanner.Float:   "impossible token received after describing a merging group - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a merging group - SLASH",
+		scanner.Unknown: "impossible token received after describing a merging group - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a merging group - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a merging group - WORD",
+		scanner.Integer: "unexpected token received after describing a merging group - INTEGER",
+		scanner.Float:   "unexpected token received after describing a merging group - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a merging group - SLASH",
+		scanner.Space:   "impossible token received after describing a merging group - SPACE",
+		scanner.Unknown: "unexpected token received after describing a merging group - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a merging group - UNKNOWN",
+	},
+}
+
+// The scanner modes of the merging group.
+var mergingGroupModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the merging group.
+func newMergingGroupMachine() elementParser {
+	var element = new(types.MergingGroup)
+	var m = newGeneratedMachine(mergingGroupMatrix, mergingGroupErrors, mergingGroupModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.MergingGroup)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "off":
+			element.Number = 0
+			return nil
+		}
+		if _, err := strconv.Atoi(token); err != nil {
+			return errors.New("the group number parameter must be an integer or take the values 'off'")
+		}
+		var value, err = strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to an integer when reading group number")
+		}
+		element.Number = int(value)
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		if element.Number == 0 {
+			return errors.New("the resolution parameter is not specified after 'off'")
+		}
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading resolution")
+		}
+		element.Resolution = value
+		return nil
+	}
+	m.checks[3] = func() error {
+		if element.Number != 0 {
+			return errors.New("parameter resolution is not specified")
+		}
+		return nil
+	}
+	m.checks[4] = func() error {
+		if element.Number != 0 {
+			return errors.New("parameter resolution is not specified")
+		}
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the object.
+var objectMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the object.
+var objectErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the object are not specified",
+		scanner.EOF:     "all parameters of the object are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid object name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid object name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid object name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the object name - SPACE",
+		scanner.EOL:     "parameter object name is not specified",
+		scanner.EOF:     "parameter object name is not specified",
+		scanner.Unknown: "invalid object name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the object name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a object - WORD",
+		scanner.Integer: "impossible token received after describing a object - INTEGER",
+		scanner.Float:   "impossible token received after describing a object - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a object - SLASH",
+		scanner.Unknown: "impossible token received after describing a object - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a object - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a object - WORD",
+		scanner.Integer: "unexpected token received after describing a object - INTEGER",
+		scanner.Float:   "unexpected token received after describing a object - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a object - SLASH",
+		scanner.Space:   "impossible token received after describing a object - SPACE",
+		scanner.Unknown: "unexpected token received after describing a object - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a object - UNKNOWN",
+	},
+}
+
+// The scanner modes of the object.
+var objectModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Text, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the object.
+func newObjectMachine() elementParser {
+	var element = new(types.Object)
+	var m = newGeneratedMachine(objectMatrix, objectErrors, objectModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.Object)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		element.Name = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the map library.
+var mapLibraryMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{5, 1, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+}
+
+// The error messages of the map library.
+var mapLibraryErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the map library are not specified",
+		scanner.EOF:     "all parameters of the map library are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid file name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid file name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid file name number 1, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the file name number 1 - SPACE",
+		scanner.EOL:     "parameter file name number 1 is not specified",
+		scanner.EOF:     "parameter file name number 1 is not specified",
+		scanner.Unknown: "invalid file name number 1, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the file name number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after file name number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after file name number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after file name number 1 - FLOAT",
+		scanner.Slash:   "invalid token after file name number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after file name number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after file name number 1 - COMMENT",
+	},
+	{
+		scanner.Integer: "invalid file name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid file name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid additional file name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional file name - SPACE",
+		scanner.Unknown: "invalid additional file name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional file name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional file name - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional file name - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional file name - FLOAT",
+		scanner.Slash:   "invalid token after additional file name, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional file name - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional file name - COMMENT",
+	},
+}
+
+// The scanner modes of the map library.
+var mapLibraryModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Names, scanner.Tokens}
+
+// Creates a new elementParser of the map library.
+func newMapLibraryMachine() elementParser {
+	var element = new(types.MapLibrary)
+	var m = newGeneratedMachine(mapLibraryMatrix, mapLibraryErrors, mapLibraryModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.MapLibrary)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Files)
+		element.Files[len(element.Files)-1] = token
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Files)
+		element.Files[len(element.Files)-1] = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the use mapping.
+var useMappingMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the use mapping.
+var useMappingErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the use mapping are not specified",
+		scanner.EOF:     "all parameters of the use mapping are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid map name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid map name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid map name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the map name - SPACE",
+		scanner.EOL:     "parameter map name is not specified",
+		scanner.EOF:     "parameter map name is not specified",
+		scanner.Unknown: "invalid map name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the map name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a use mapping - WORD",
+		scanner.Integer: "impossible token received after describing a use mapping - INTEGER",
+		scanner.Float:   "impossible token received after describing a use mapping - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a use mapping - SLASH",
+		scanner.Unknown: "impossible token received after describing a use mapping - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a use mapping - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a use mapping - WORD",
+		scanner.Integer: "unexpected token received after describing a use mapping - INTEGER",
+		scanner.Float:   "unexpected token received after describing a use mapping - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a use mapping - SLASH",
+		scanner.Space:   "impossible token received after describing a use mapping - SPACE",
+		scanner.Unknown: "unexpected token received after describing a use mapping - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a use mapping - UNKNOWN",
+	},
+}
+
+// The scanner modes of the use mapping.
+var useMappingModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the use mapping.
+func newUseMappingMachine() elementParser {
+	var element = new(types.UseMapping)
+	var m = newGeneratedMachine(useMappingMatrix, useMappingErrors, useMappingModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.UseMapping)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		element.Name = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the use material.
+var useMaterialMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the use material.
+var useMaterialErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the use material are not specified",
+		scanner.EOF:     "all parameters of the use material are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid material name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid material name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid material name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the material name - SPACE",
+		scanner.EOL:     "parameter material name is not specified",
+		scanner.EOF:     "parameter material name is not specified",
+		scanner.Unknown: "invalid material name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the material name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a use material - WORD",
+		scanner.Integer: "impossible token received after describing a use material - INTEGER",
+		scanner.Float:   "impossible token received after describing a use material - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a use material - SLASH",
+		scanner.Unknown: "impossible token received after describing a use material - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a use material - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a use material - WORD",
+		scanner.Integer: "unexpected token received after describing a use material - INTEGER",
+		scanner.Float:   "unexpected token received after describing a use material - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a use material - SLASH",
+		scanner.Space:   "impossible token received after describing a use material - SPACE",
+		scanner.Unknown: "unexpected token received after describing a use material - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a use material - UNKNOWN",
+	},
+}
+
+// The scanner modes of the use material.
+var useMaterialModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the use material.
+func newUseMaterialMachine() elementParser {
+	var element = new(types.UseMaterial)
+	var m = newGeneratedMachine(useMaterialMatrix, useMaterialErrors, useMaterialModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.UseMaterial)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		element.Name = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the material library.
+var materialLibraryMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+	{5, 1, 1, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 4, 0, 0, 1, 1},
+}
+
+// The error messages of the material library.
+var materialLibraryErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the material library are not specified",
+		scanner.EOF:     "all parameters of the material library are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid file name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid file name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid file name number 1, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the file name number 1 - SPACE",
+		scanner.EOL:     "parameter file name number 1 is not specified",
+		scanner.EOF:     "parameter file name number 1 is not specified",
+		scanner.Unknown: "invalid file name number 1, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the file name number 1 - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after file name number 1 - WORD",
+		scanner.Integer: "impossible token received when reading the token after file name number 1 - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after file name number 1 - FLOAT",
+		scanner.Slash:   "invalid token after file name number 1, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after file name number 1 - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after file name number 1 - COMMENT",
+	},
+	{
+		scanner.Integer: "invalid file name, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid file name, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid additional file name, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the additional file name - SPACE",
+		scanner.Unknown: "invalid additional file name, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the additional file name - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the token after additional file name - WORD",
+		scanner.Integer: "impossible token received when reading the token after additional file name - INTEGER",
+		scanner.Float:   "impossible token received when reading the token after additional file name - FLOAT",
+		scanner.Slash:   "invalid token after additional file name, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the token after additional file name - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the token after additional file name - COMMENT",
+	},
+}
+
+// The scanner modes of the material library.
+var materialLibraryModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Names, scanner.Tokens, scanner.Names, scanner.Tokens}
+
+// Creates a new elementParser of the material library.
+func newMaterialLibraryMachine() elementParser {
+	var element = new(types.MaterialLibrary)
+	var m = newGeneratedMachine(materialLibraryMatrix, materialLibraryErrors, materialLibraryModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.MaterialLibrary)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		appendZero(&element.Files)
+		element.Files[len(element.Files)-1] = token
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		appendZero(&element.Files)
+		element.Files[len(element.Files)-1] = token
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the curve approximation technique.
+var curveApproximationMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 7, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the curve approximation technique.
+var curveApproximationErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the curve approximation technique are not specified",
+		scanner.EOF:     "all parameters of the curve approximation technique are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid technique, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid technique, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid technique, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the technique - SPACE",
+		scanner.EOL:     "parameters technique, value are not specified",
+		scanner.EOF:     "parameters technique, value are not specified",
+		scanner.Unknown: "invalid technique, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the technique - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between technique and value - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between technique and value - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between technique and value - FLOAT",
+		scanner.Slash:   "invalid delimiter between technique and value, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter value is not specified",
+		scanner.EOF:     "parameter value is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between technique and value - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between technique and value - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the value - SPACE",
+		scanner.EOL:     "parameter value is not specified",
+		scanner.EOF:     "parameter value is not specified",
+		scanner.Unknown: "invalid value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between value and maximum angle - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between value and maximum angle - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between value and maximum angle - FLOAT",
+		scanner.Slash:   "invalid delimiter between value and maximum angle, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between value and maximum angle - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between value and maximum angle - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid maximum angle, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid maximum angle, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the maximum angle - SPACE",
+		scanner.Unknown: "invalid maximum angle, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the maximum angle - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a curve approximation technique - WORD",
+		scanner.Integer: "impossible token received after describing a curve approximation technique - INTEGER",
+		scanner.Float:   "impossible token received after describing a curve approximation technique - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a curve approximation technique - SLASH",
+		scanner.Unknown: "impossible token received after describing a curve approximation technique - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a curve approximation technique - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a curve approximation technique - WORD",
+		scanner.Integer: "unexpected token received after describing a curve approximation technique - INTEGER",
+		scanner.Float:   "unexpected token received after describing a curve approximation technique - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a curve approximation technique - SLASH",
+		scanner.Space:   "impossible token received after describing a curve approximation technique - SPACE",
+		scanner.Unknown: "unexpected token received after describing a curve approximation technique - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a curve approximation technique - UNKNOWN",
+	},
+}
+
+// The scanner modes of the curve approximation technique.
+var curveApproximationModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the curve approximation technique.
+func newCurveApproximationMachine() elementParser {
+	var element = new(types.CurveApproximation)
+	var m = newGeneratedMachine(curveApproximationMatrix, curveApproximationErrors, curveApproximationModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.CurveApproximation)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "cparm":
+			element.Technique = 0
+		case "cspace":
+			element.Technique = 1
+		case "curv":
+			element.Technique = 2
+		default:
+			return errors.New("the technique parameter must take the values 'cparm', 'cspace' or 'curv'")
+		}
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading value")
+		}
+		element.Value = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		if element.Technique != 2 {
+			return errors.New("the maximum angle parameter is only specified after 'curv'")
+		}
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading maximum angle")
+		}
+		element.MaxAngle = value
+		return nil
+	}
+	m.checks[5] = func() error {
+		if element.Technique == 2 {
+			return errors.New("parameter maximum angle is not specified")
+		}
+		return nil
+	}
+	m.checks[6] = func() error {
+		if element.Technique == 2 {
+			return errors.New("parameter maximum angle is not specified")
+		}
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
+
+// The transition table of the surface approximation technique.
+var surfaceApproximationMatrix = [][scanner.TokensCount]stateType{
+	{1, 1, 1, 1, 2, 1, 1, 1, 1},
+	{1, 1, 1, 1, 1, 1, 1, 1, 1},
+	{3, 1, 1, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 4, 1, 1, 1, 1},
+	{1, 5, 5, 1, 1, 1, 1, 1, 1},
+	{1, 1, 1, 1, 6, 0, 0, 1, 1},
+	{1, 7, 7, 1, 1, 0, 0, 1, 1},
+	{1, 1, 1, 1, 8, 0, 0, 1, 1},
+	{1, 1, 1, 1, 1, 0, 0, 1, 1},
+}
+
+// The error messages of the surface approximation technique.
+var surfaceApproximationErrors = [][scanner.TokensCount]string{
+	{
+		scanner.Word:    "impossible token received in the start state - WORD",
+		scanner.Integer: "impossible token received in the start state - INTEGER",
+		scanner.Float:   "impossible token received in the start state - INTEGER",
+		scanner.Slash:   "impossible token received in the start state - SLASH",
+		scanner.EOL:     "all parameters of the surface approximation technique are not specified",
+		scanner.EOF:     "all parameters of the surface approximation technique are not specified",
+		scanner.Unknown: "impossible token received in the start state - UNKNOWN",
+		scanner.Comment: "impossible token received in the start state - COMMENT",
+	},
+	{
+		scanner.Word:    "parser cannot be used in the error state",
+		scanner.Integer: "parser cannot be used in the error state",
+		scanner.Float:   "parser cannot be used in the error state",
+		scanner.Slash:   "parser cannot be used in the error state",
+		scanner.Space:   "parser cannot be used in the error state",
+		scanner.EOL:     "parser cannot be used in the error state",
+		scanner.EOF:     "parser cannot be used in the error state",
+		scanner.Unknown: "parser cannot be used in the error state",
+		scanner.Comment: "parser cannot be used in the error state",
+	},
+	{
+		scanner.Integer: "invalid technique, expected: WORD, received: INTEGER",
+		scanner.Float:   "invalid technique, expected: WORD, received: FLOAT",
+		scanner.Slash:   "invalid technique, expected: WORD, received: SLASH",
+		scanner.Space:   "impossible token received when reading the technique - SPACE",
+		scanner.EOL:     "parameters technique, value are not specified",
+		scanner.EOF:     "parameters technique, value are not specified",
+		scanner.Unknown: "invalid technique, expected: WORD, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the technique - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between technique and value - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between technique and value - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between technique and value - FLOAT",
+		scanner.Slash:   "invalid delimiter between technique and value, expected: SPACE, received: SLASH",
+		scanner.EOL:     "parameter value is not specified",
+		scanner.EOF:     "parameter value is not specified",
+		scanner.Unknown: "impossible token received when reading the delimiter between technique and value - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between technique and value - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the value - SPACE",
+		scanner.EOL:     "parameter value is not specified",
+		scanner.EOF:     "parameter value is not specified",
+		scanner.Unknown: "invalid value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received when reading the delimiter between value and second value - WORD",
+		scanner.Integer: "impossible token received when reading the delimiter between value and second value - INTEGER",
+		scanner.Float:   "impossible token received when reading the delimiter between value and second value - FLOAT",
+		scanner.Slash:   "invalid delimiter between value and second value, expected: SPACE, received: SLASH",
+		scanner.Unknown: "impossible token received when reading the delimiter between value and second value - UNKNOWN",
+		scanner.Comment: "impossible token received when reading the delimiter between value and second value - COMMENT",
+	},
+	{
+		scanner.Word:    "invalid second value, expected: FLOAT, received: WORD",
+		scanner.Slash:   "invalid second value, expected: FLOAT, received: SLASH",
+		scanner.Space:   "impossible token received when reading the second value - SPACE",
+		scanner.Unknown: "invalid second value, expected: FLOAT, received: UNKNOWN",
+		scanner.Comment: "impossible token received when reading the second value - COMMENT",
+	},
+	{
+		scanner.Word:    "impossible token received after describing a surface approximation technique - WORD",
+		scanner.Integer: "impossible token received after describing a surface approximation technique - INTEGER",
+		scanner.Float:   "impossible token received after describing a surface approximation technique - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a surface approximation technique - SLASH",
+		scanner.Unknown: "impossible token received after describing a surface approximation technique - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a surface approximation technique - UNKNOWN",
+	},
+	{
+		scanner.Word:    "unexpected token received after describing a surface approximation technique - WORD",
+		scanner.Integer: "unexpected token received after describing a surface approximation technique - INTEGER",
+		scanner.Float:   "unexpected token received after describing a surface approximation technique - FLOAT",
+		scanner.Slash:   "unexpected token received after describing a surface approximation technique - SLASH",
+		scanner.Space:   "impossible token received after describing a surface approximation technique - SPACE",
+		scanner.Unknown: "unexpected token received after describing a surface approximation technique - UNKNOWN",
+		scanner.Comment: "impossible token received after describing a surface approximation technique - UNKNOWN",
+	},
+}
+
+// The scanner modes of the surface approximation technique.
+var surfaceApproximationModes = []scanner.Mode{scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens, scanner.Tokens}
+
+// Creates a new elementParser of the surface approximation technique.
+func newSurfaceApproximationMachine() elementParser {
+	var element = new(types.SurfaceApproximation)
+	var m = newGeneratedMachine(surfaceApproximationMatrix, surfaceApproximationErrors, surfaceApproximationModes, element)
+	m.actions[first] = func(string) error {
+		element = new(types.SurfaceApproximation)
+		return nil
+	}
+	m.actions[3] = func(token string) error {
+		switch token {
+		case "cparma":
+			element.Technique = 0
+		case "cspace":
+			element.Technique = 1
+		case "curv":
+			element.Technique = 2
+		case "cparmb":
+			element.Technique = 3
+		default:
+			return errors.New("the technique parameter must take the values 'cparma', 'cspace', 'curv' or 'cparmb'")
+		}
+		return nil
+	}
+	m.actions[5] = func(token string) error {
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading value")
+		}
+		element.Value = value
+		return nil
+	}
+	m.actions[7] = func(token string) error {
+		if element.Technique != 0 && element.Technique != 2 {
+			return errors.New("the second value parameter is only specified after 'cparma' or 'curv'")
+		}
+		var value, err = strconv.ParseFloat(token, 64)
+		if err != nil {
+			return errors.New("failed to convert the token to a float when reading second value")
+		}
+		element.Second = value
+		return nil
+	}
+	m.checks[5] = func() error {
+		if element.Technique == 0 || element.Technique == 2 {
+			return errors.New("parameter second value is not specified")
+		}
+		return nil
+	}
+	m.checks[6] = func() error {
+		if element.Technique == 0 || element.Technique == 2 {
+			return errors.New("parameter second value is not specified")
+		}
+		return nil
+	}
+	m.current = func() interface{} { return element }
+	return m
+}
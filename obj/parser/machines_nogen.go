@@ -0,0 +1,6 @@
+//go:build nogenerated
+
+package parser
+
+// The generated elementParsers are excluded from the build, all the elementParsers are built by the buildParser.
+var generatedParsers = map[ElementType]func() elementParser{}
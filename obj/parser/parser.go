@@ -2,10 +2,8 @@ package parser
 
 import (
 	"computer_graphics/obj/scanner"
-	"fmt"
 	"io"
 	"os"
-	"strings"
 )
 
 // One of the possible types of description of model types, according to the specification of .obj files.
@@ -53,10 +51,12 @@ const (
 	Scmp                                     // Scmp: scmp filename.ext arg1 arg2 ...
 	Csh                                      // Csh: csh command || csh -command.
 	EndOfFile                                // A special marker that indicates that the parser has reached the end of the file.
+	UnknownElement                           // A special marker of the diagnostics that do not refer to a certain type of element.
 )
 
 // Converts a element type constant to its string representation.
-var elementsMap = [...]string{
+// The names of the element types registered by the Register function are appended to it.
+var elementsMap = []string{
 	"vertex",
 	"vertex texture",
 	"vertex normal",
@@ -98,6 +98,7 @@ var elementsMap = [...]string{
 	"scmp command",
 	"csh command",
 	"end of file",
+	"unknown element",
 }
 
 // Converts a element type constant to its string representation.
@@ -105,20 +106,38 @@ func (elementType ElementType) String() string {
 	return elementsMap[elementType]
 }
 
+// Determines how the Parser handles the lines that do not match the specification.
+type Strictness uint8
+
+const (
+	Normal  Strictness = iota // The lines containing an error are reported and skipped.
+	Strict                    // The parsing is aborted at the first error or warning.
+	Lenient                   // Common deviations are accepted, the remaining lines are handled as in the Normal mode.
+)
+
 // Allows you to call the Next method sequentially to get elements from the .obj file.
-// Display information about problems that occur during parsing.
-// You can disable the output by using the IgnoreWarnings and IgnoreErrors methods.
-// You can also specify io.Writer to output this information to.
+// Reports the problems that occur during parsing as diagnostics.
+// You can disable the reporting by using the IgnoreWarnings and IgnoreErrors methods.
+// You can also specify io.Writer to output the text representation of the diagnostics to
+// and a DiagnosticHandler to receive them.
 type Parser interface {
 	// Returns the next element read from the reader.
 	// Lines of unsupported format and lines containing an error are skipped and searched for matches further.
 	// Ensures that the returned object can be safely cast to the structure from the package types
 	// corresponding to the constant ElementType.
+	// The elements of the statements registered by the Register function have the type of the registered element.
 	// When the end of the file is reached, it always returns (EndOfFile, nil).
 	Next() (ElementType, interface{})
 	// Sets a new io.Writer for displaying error and warning messages.
 	// If nil is set, no messages will be output.
+	// See the WriteDiagnostic function for the format of the messages.
 	Output(w io.Writer)
+	// Sets a function that receives the errors and warnings in addition to the output to the io.Writer.
+	// If nil is set, the diagnostics are only output to the io.Writer.
+	Handle(h DiagnosticHandler)
+	// Reports a diagnostic about the file in the same way as the problems found by the Parser itself.
+	// Allows the code using the Parser to apply its output, ignoring and aborting settings to its own problems.
+	Report(d Diagnostic)
 	// Enables or disables the warning output.
 	IgnoreWarnings(iw bool)
 	// Returns true if Parser does not output warnings.
@@ -129,16 +148,36 @@ type Parser interface {
 	IsIgnoreErrors() bool
 	// Returns the number of the line that was last processed by the Parser.
 	Line() int
+	// Returns the error that occurred while reading the file, or nil.
+	// If the parsing is aborted, an *AbortError is returned.
+	// After the error, the Next method behaves as if the end of the file has been reached.
+	Err() error
+	// Sets the way the Parser handles the lines that do not match the specification.
+	//
+	// In the Strict mode, the parsing is aborted at the first error or warning, even if they are ignored.
+	//
+	// In the Lenient mode, the following deviations are accepted:
+	// 	* extra values at the end of the vertex, including the vertex colors: v x y z r g b;
+	// 	* spaces at the end of the line.
+	SetStrictness(s Strictness)
+	// Returns the way the Parser handles the lines that do not match the specification.
+	Strictness() Strictness
+	// Sets the number of errors after which the parsing is aborted.
+	// If 0 is set, the number of errors is not limited.
+	SetMaxErrors(n int)
+	// Returns the number of errors after which the parsing is aborted, 0 if it is not limited.
+	MaxErrors() int
 }
 
 // Creates a new .obj file parser.
 // By default, it outputs all errors and warnings in os.Stderr.
 // This can be changed by using the Parser.Output, Parser.IgnoreWarnings, Parser.IgnoreErrors methods.
 func NewParser(reader io.Reader) Parser {
-	return &parser{scanner: scanner.NewScanner(reader), outputWriter: os.Stderr}
+	return &parser{scanner: scanner.NewScanner(reader), reporter: reporter{outputWriter: os.Stderr}}
 }
 
 // Sets the match between the first word in the line in .obj file and the type of the element that is written in this line.
+// The names of the statements registered by the Register function are added to it.
 var elementDeclarationsMap = map[string]ElementType{
 	"v":          Vertex,
 	"vt":         VertexTexture,
@@ -229,6 +268,12 @@ type elementParser interface {
 	// Returns information about the error by the state from which the elementParser went to the err state
 	// and the type of token that was received when going to the err state.
 	message(tokenType scanner.TokenType, state stateType) string
+	// Returns the mode in which the scanner must read the token following the state.
+	// Allows reading the values that cannot be described by a single token, such as names and paths.
+	mode(state stateType) scanner.Mode
+	// Checks the element when the end of the line is read in the state.
+	// Returns an error if the line cannot end in the state because of the values read before it.
+	check(state stateType) error
 	// Returns a structure containing the read data from the string.
 	// The elementParser must ensure that the return value can be safely cast
 	// to the appropriate structure from the package types.
@@ -237,130 +282,148 @@ type elementParser interface {
 
 // Implements the Parser interface.
 type parser struct {
-	scanner        scanner.Scanner // A scanner that splits the input file into tokens.
-	outputWriter   io.Writer       // Recipient of error and warning messages.
-	ignoreWarnings bool            // If true, no error messages will be output to the outputWriter.
-	ignoreErrors   bool            // If true, no warning messages will be output to the outputWriter.
+	scanner  scanner.Scanner // A scanner that splits the input file into tokens.
+	reporter                 // Recipient of error and warning diagnostics.
 }
 
-// Type of output message.
-type logType uint8
-
-const (
-	e logType = iota // Error type.
-	w                // Warning type.
-)
-
-// Converts the output message type to its string representation.
-func (t logType) String() string {
-	switch t {
-	case e:
-		return "ERROR"
-	case w:
-		return "WARNING"
+// Reports a diagnostic about the token of the element.
+// Note that the method skips a line and adds it to the diagnostic.
+func (parser *parser) log(msg, token string, elementType ElementType, severity Severity) {
+	var tokenLength int
+	switch token {
+	case "\n":
+		token = "eol"
+		tokenLength = 1
+	case "":
+		token = "eof"
+		tokenLength = 1
 	default:
-		panic("unknown log type")
+		tokenLength = len(token)
 	}
+	var column = parser.scanner.Column() - tokenLength + 2
+	parser.scanner.SkipLine()
+	parser.report(Diagnostic{
+		Severity:    severity,
+		Line:        parser.scanner.Line() + 1,
+		Column:      column,
+		Token:       token,
+		ElementType: elementType,
+		Message:     msg,
+		LineString:  parser.scanner.LineString(),
+	})
 }
 
-// Outputs a message in outputWriter in the format:
-// [{log type}] line: {line number}, column: {column number}, token: '{token string}', message: {log message}
-// After that, it outputs the line where the token occurred, highlighting the token.
-// Note that the method skips a line and adds information about it to the msg.
-func (parser *parser) log(msg, token string, t logType) {
-	if !(t == e && parser.ignoreErrors || t == w && parser.ignoreWarnings) && parser.outputWriter != nil {
-		var (
-			tokenLength   int
-			logTypeString = t.String()
-		)
-		switch token {
-		case "\n":
-			token = "eol"
-			tokenLength = 1
-		case "":
-			token = "eof"
-			tokenLength = 1
-		default:
-			tokenLength = len(token)
+// Implementation of the Next method in the Parser interface.
+// The lines that are not read are skipped in a loop, so any number of them does not grow the stack.
+func (parser *parser) Next() (ElementType, interface{}) {
+	for {
+		// After the parsing is aborted, the rest of the file is not read.
+		if parser.aborted != nil {
+			return EndOfFile, nil
+		}
+		if elementType, element, ok := parser.readLine(); ok {
+			return elementType, element
 		}
-		var column = parser.scanner.Column() - tokenLength + 2
-		parser.scanner.SkipLine()
-		fmt.Fprintf(
-			parser.outputWriter,
-			"[%s] line: %d, column: %d, token: '%s', message: %s%s\n",
-			logTypeString,
-			parser.scanner.Line()+1,
-			column,
-			token,
-			msg,
-			", the line will be skipped",
-		)
-		fmt.Fprintln(
-			parser.outputWriter,
-			strings.Repeat(" ", len(logTypeString)+2),
-			"->",
-			parser.scanner.LineString(),
-			"\n",
-			strings.Repeat(" ", column+len(logTypeString)+3),
-			strings.Repeat("^", tokenLength),
-		)
-	} else {
-		parser.scanner.SkipLine()
 	}
 }
 
-// Implementation of the Next method in the Parser interface.
-func (parser *parser) Next() (ElementType, interface{}) {
+// Reads the element from the next non-empty line.
+// Returns false if the line is skipped because of an error or an unsupported format.
+func (parser *parser) readLine() (ElementType, interface{}, bool) {
 	// Skipping empty lines.
+	// The comments are read only if the statements written in comments are registered.
+	parser.scanner.SetMode(scanner.Tokens)
+	parser.scanner.SkipComments(!commentStatementsRegistered)
 	var tokenType, token = parser.scanner.Next()
-	for tokenType == scanner.EOL || tokenType == scanner.Space {
+	for tokenType == scanner.EOL || tokenType == scanner.Space ||
+		tokenType == scanner.Comment && !isCommentStatement(token) {
 		tokenType, token = parser.scanner.Next()
 	}
+	parser.scanner.SkipComments(true)
 	// When the end of the file is reached, it always returns (EndOfFile, nil).
 	if tokenType == scanner.EOF {
-		return EndOfFile, nil
+		return EndOfFile, nil, true
+	}
+	// The statement written in a comment is read from the text of the comment following its name.
+	if tokenType == scanner.Comment {
+		var (
+			name        = commentStatementName(token)
+			elementType = elementDeclarationsMap[name]
+			file        = parser.scanner
+		)
+		parser.scanner = newCommentStatementScanner(file, token, len(name))
+		defer func() { parser.scanner = file }()
+		return parser.readElement(elementType, parsersRegistry[elementType])
 	}
 	// If the first token in the String is found in the registry of possible formats for describing the model element,
 	// the String is processed by a parser from the registry.
-	if elementType, ok := elementDeclarationsMap[token]; tokenType == scanner.Word && ok {
-		var p = parsersRegistry[elementType]
-		// If the parser from the registry is nil, then the format is not supported.
-		if p != nil {
-			var (
-				prevState stateType // Contains the previous state of the parser to get the error message.
-				state     stateType // Contains the parser state of a specific element.
-				er        error
-			)
-			for {
-				tokenType, token = parser.scanner.Next()
-				prevState = state
-				state = p.transition(tokenType, prevState)
-				switch state {
-				// The transition to the start state means the successful completion of the parser.
-				case start:
-					return elementType, p.result()
-				// The transition to the error state means an erroneous entry of the element.
-				// The erroneous line must be skipped and the next element must be searched for.
-				case err:
-					parser.log(p.message(tokenType, prevState), token, e)
-					return parser.Next()
-				default:
-					er = p.action(state, token)
-					if er != nil {
-						parser.log(er.Error(), token, e)
-						return parser.Next()
-					}
-				}
+	var elementType, ok = elementDeclarationsMap[token]
+	if tokenType != scanner.Word || !ok {
+		parser.log("error in the name of the element type", token, UnknownElement, Error)
+		return UnknownElement, nil, false
+	}
+	var p = parsersRegistry[elementType]
+	if lp, ok := lenientParsersRegistry[elementType]; ok && parser.strictness == Lenient {
+		p = lp
+	}
+	// If the parser from the registry is nil, then the format is not supported.
+	if p == nil {
+		parser.log("unsupported element format - "+elementType.String(), token, elementType, Warning)
+		return elementType, nil, false
+	}
+	return parser.readElement(elementType, p)
+}
+
+// Reads the element of the specified type from the rest of the line by the elementParser.
+// Returns false if the line is skipped because of an error.
+func (parser *parser) readElement(elementType ElementType, p elementParser) (ElementType, interface{}, bool) {
+	var (
+		tokenType   scanner.TokenType
+		token       string
+		prevState   stateType // Contains the previous state of the parser to get the error message.
+		state       stateType // Contains the parser state of a specific element.
+		beforeSpace stateType // Contains the state before the last token if it is a space, otherwise start.
+		er          error
+	)
+	for {
+		parser.scanner.SetMode(p.mode(state))
+		tokenType, token = parser.scanner.Next()
+		prevState = state
+		state = p.transition(tokenType, prevState)
+		// In the Lenient mode, the trailing spaces are ignored:
+		// the end of the line is passed to the state before them.
+		if state == err && parser.strictness == Lenient && beforeSpace != start &&
+			(tokenType == scanner.EOL || tokenType == scanner.EOF) {
+			if state = p.transition(tokenType, beforeSpace); state != err {
+				prevState = beforeSpace
 			}
+		}
+		if tokenType == scanner.Space {
+			beforeSpace = prevState
 		} else {
-			parser.log("unsupported element format - "+elementType.String(), token, w)
+			beforeSpace = start
+		}
+		switch state {
+		// The transition to the start state means the successful completion of the parser.
+		case start:
+			if er = p.check(prevState); er != nil {
+				parser.log(er.Error(), token, elementType, Error)
+				return elementType, nil, false
+			}
+			return elementType, p.result(), true
+		// The transition to the error state means an erroneous entry of the element.
+		// The erroneous line must be skipped and the next element must be searched for.
+		case err:
+			parser.log(p.message(tokenType, prevState), token, elementType, Error)
+			return elementType, nil, false
+		default:
+			er = p.action(state, token)
+			if er != nil {
+				parser.log(er.Error(), token, elementType, Error)
+				return elementType, nil, false
+			}
 		}
-	} else {
-		parser.log("error in the name of the element type", token, e)
 	}
-	// If the line was not read, it means that the parser was not found in the registry,
-	// need to search for the next element.
-	return parser.Next()
 }
 
 // Implementation of the Output method in the Parser interface.
@@ -368,6 +431,16 @@ func (parser *parser) Output(w io.Writer) {
 	parser.outputWriter = w
 }
 
+// Implementation of the Handle method in the Parser interface.
+func (parser *parser) Handle(h DiagnosticHandler) {
+	parser.handler = h
+}
+
+// Implementation of the Report method in the Parser interface.
+func (parser *parser) Report(d Diagnostic) {
+	parser.report(d)
+}
+
 // Implementation of the IgnoreWarnings method in the Parser interface.
 func (parser *parser) IgnoreWarnings(iw bool) {
 	parser.ignoreWarnings = iw
@@ -392,3 +465,31 @@ func (parser *parser) IsIgnoreErrors() bool {
 func (parser *parser) Line() int {
 	return parser.scanner.Line()
 }
+
+// Implementation of the Err method in the Parser interface.
+func (parser *parser) Err() error {
+	if parser.aborted != nil {
+		return parser.aborted
+	}
+	return parser.scanner.Err()
+}
+
+// Implementation of the SetStrictness method in the Parser interface.
+func (parser *parser) SetStrictness(s Strictness) {
+	parser.strictness = s
+}
+
+// Implementation of the Strictness method in the Parser interface.
+func (parser *parser) Strictness() Strictness {
+	return parser.strictness
+}
+
+// Implementation of the SetMaxErrors method in the Parser interface.
+func (parser *parser) SetMaxErrors(n int) {
+	parser.maxErrors = n
+}
+
+// Implementation of the MaxErrors method in the Parser interface.
+func (parser *parser) MaxErrors() int {
+	return parser.maxErrors
+}
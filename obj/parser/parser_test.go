@@ -1,8 +1,13 @@
 package parser
 
 import (
+	"computer_graphics/obj/parser/types"
 	"fmt"
+	"io"
 	"os"
+	"runtime/debug"
+	"strings"
+	"testing"
 )
 
 // Reads all vertices from a file containing errors and an unsupported format.
@@ -38,17 +43,20 @@ func ExampleParser_Next_vertices() {
 		elementType, element = parser.Next()
 	}
 	// Output:
-	//vertex : &{-0.046146 0.050437 0.002961 0}
-	//vertex : &{-0.045498 0.049687 0.001989 0}
-	//vertex : &{-0.045306 0.049655 0.002956 3434}
-	//vertex : &{-0.045935 0.050494 0.003832 0}
-	//vertex : &{-0.044743 0.048768 0.002943 0}
-	//vertex : &{-0.044832 0.048663 0.001729 0}
-	//vertex : &{-0.047369 0.051618 0.004211 0}
-	//vertex : &{-0.044734 0.04789 0.002286 0}
-	//vertex : &{-0.045207 0.050247 0.004572 0}
-	//vertex : &{-0.046589 0.05193 0.006586 0}
-	//vertex : &{-0.044529 0.047892 0.003273 0}
+	//vertex : &{-0.046146 0.050437 0.002961 0 <nil>}
+	//vertex : &{-0.045498 0.049687 0.001989 0 <nil>}
+	//vertex : &{-0.045306 0.049655 0.002956 3434 <nil>}
+	//vertex : &{-0.045935 0.050494 0.003832 0 <nil>}
+	//vertex : &{-0.044743 0.048768 0.002943 0 <nil>}
+	//vertex : &{-0.044832 0.048663 0.001729 0 <nil>}
+	//vertex : &{-0.047369 0.051618 0.004211 0 <nil>}
+	//vertex : &{-0.044734 0.04789 0.002286 0 <nil>}
+	//vertex : &{-0.045207 0.050247 0.004572 0 <nil>}
+	//vertex : &{-0.046589 0.05193 0.006586 0 <nil>}
+	//vertex : &{-0.044529 0.047892 0.003273 0 <nil>}
+	//vertex : &{1.5e-05 0.25 0.5 3 <nil>}
+	//vertex : &{0.5 0.5 0.5 0 {1 0.5 0}}
+	//vertex : &{0.5 0.5 0.5 2 {1 0.5 0}}
 }
 
 // Reads all faces from a file containing errors and an unsupported format.
@@ -113,3 +121,401 @@ func ExampleParser_Next_faces() {
 	//face : &{[{17 17 17} {22 22 22} {29 29 29}]}
 	//face : &{[{23 23 23} {18 18 18} {26 26 26}]}
 }
+
+// Reads all texture vertices from a file containing errors and an unsupported format.
+// Check the testdata/output/vertex_textures_output.txt file for information about errors and warnings!
+func ExampleParser_Next_vertexTextures() {
+	input, err := os.Open("testdata/vertex_textures.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/vertex_textures_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewParser(input)
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		if elementType == VertexTexture {
+			fmt.Printf("%s : %v\n", elementType, element)
+		} else {
+			fmt.Fprintf(output, "[INFO] unnecessary element: %s\n", elementType)
+		}
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//vertex texture : &{0.528712 0.678552 0}
+	//vertex texture : &{0.545417 0.676032 0}
+	//vertex texture : &{0.617856 0 0}
+	//vertex texture : &{0.094965 0.707402 0.5}
+	//vertex texture : &{1 1 0}
+	//vertex texture : &{0.204939 0.712126 0}
+}
+
+// Reads all vertex normals from a file containing errors and an unsupported format.
+// Check the testdata/output/vertex_normals_output.txt file for information about errors and warnings!
+func ExampleParser_Next_vertexNormals() {
+	input, err := os.Open("testdata/vertex_normals.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/vertex_normals_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewParser(input)
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		if elementType == VertexNormal {
+			fmt.Printf("%s : %v\n", elementType, element)
+		} else {
+			fmt.Fprintf(output, "[INFO] unnecessary element: %s\n", elementType)
+		}
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//vertex normal : &{0 -0.9248 0.3804}
+	//vertex normal : &{-0.7469 -0.6386 0.1852}
+	//vertex normal : &{0 0 -1}
+	//vertex normal : &{-0.3527 0.137 -0.9256}
+}
+
+// Reads all curves and surfaces from a file containing errors.
+// Check the testdata/output/freeform_output.txt file for information about errors and warnings!
+func ExampleNewBlockParser() {
+	input, err := os.Open("testdata/freeform.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/freeform_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewBlockParser(NewParser(input))
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		switch elementType {
+		case Curve, Curve2D, Surface:
+			var block = element.(*FreeForm)
+			fmt.Printf(
+				"%s : type: %v, degree: %v, u: %v, v: %v, trims: %d, holes: %d, special points: %d\n",
+				elementType,
+				*block.Attributes.Type,
+				*block.Attributes.Degree,
+				block.UParameters,
+				block.VParameters,
+				len(block.Trims),
+				len(block.Holes),
+				len(block.SpecialPoints),
+			)
+		default:
+			fmt.Fprintf(output, "[INFO] unnecessary element: %s\n", elementType)
+		}
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//curve 2D : type: {false 1}, degree: {1 0}, u: [0 1 2 3 4], v: [], trims: 0, holes: 0, special points: 0
+	//curve 2D : type: {false 1}, degree: {1 0}, u: [0 1 2 3 4], v: [], trims: 0, holes: 0, special points: 0
+	//surface : type: {false 1}, degree: {3 3}, u: [0 1], v: [0 1], trims: 1, holes: 1, special points: 1
+	//curve : type: {false 2}, degree: {2 0}, u: [0 0 0 1 2 2 2], v: [], trims: 0, holes: 0, special points: 0
+	//curve : type: {false 0}, degree: {1 0}, u: [0 1], v: [], trims: 0, holes: 0, special points: 0
+}
+
+// Reads all group, object, smoothing and merging group statements from a file containing errors.
+// Check the testdata/output/groups_output.txt file for information about errors and warnings!
+func ExampleParser_Next_groups() {
+	input, err := os.Open("testdata/groups.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/groups_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewParser(input)
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		switch elementType {
+		case Group, Object, SmoothingGroup, MergingGroup:
+			fmt.Printf("%s : %v\n", elementType, element)
+		default:
+			fmt.Fprintf(output, "[INFO] unnecessary element: %s\n", elementType)
+		}
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//object : &{cube}
+	//group : &{[front left]}
+	//smoothing group : &{1}
+	//group : &{[back]}
+	//smoothing group : &{0}
+	//merging group : &{1 0.5}
+	//merging group : &{0 0}
+	//group : &{[1]}
+	//object : &{sphere}
+	//group : &{[body-left Группа.2]}
+	//object : &{My object}
+}
+
+// Reads all material library and material statements from a file containing errors.
+// Check the testdata/output/materials_output.txt file for information about errors and warnings!
+func ExampleParser_Next_materials() {
+	input, err := os.Open("testdata/materials.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/materials_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewParser(input)
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		switch elementType {
+		case MaterialLibrary, UseMaterial, MapLibrary, UseMapping, Call:
+			fmt.Printf("%s : %v\n", elementType, element)
+		default:
+			fmt.Fprintf(output, "[INFO] unnecessary element: %s\n", elementType)
+		}
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//material library : &{[low-poly-fox-by-pixelmannen.mtl]}
+	//material library : &{[textures/a.mtl b.mtl]}
+	//use material : &{fox_material}
+	//use material : &{Material.001}
+	//material library : &{[../tex/wood.mtl]}
+	//use material : &{metal-dark}
+	//map library : &{[maps.mpc]}
+	//use mapping : &{off}
+	//call command : &{../parts/wheel.obj [1 2.5]}
+}
+
+// Reads the points and lines with the optional texture vertices.
+func ExampleParser_Next_primitives() {
+	var parser = NewParser(strings.NewReader("p 1 2 -1\np\nl 1 2\nl 1/1 2/2 3/3\nl 1\nl 1//2 3\np 1/2\n"))
+	parser.Output(nil)
+	parser.Handle(func(d Diagnostic) { fmt.Println(d) })
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		fmt.Printf("%s : %v\n", elementType, element)
+		elementType, element = parser.Next()
+	}
+	// Output:
+	// point : &{[1 2 -1]}
+	// [ERROR] line: 2, column: 2, token: 'eol', message: all parameters of the point are not specified, the line will be skipped
+	// line : &{[{1 0} {2 0}]}
+	// line : &{[{1 1} {2 2} {3 3}]}
+	// [ERROR] line: 5, column: 4, token: 'eol', message: parameter vertex number 2 is not specified, the line will be skipped
+	// [ERROR] line: 6, column: 5, token: '/', message: invalid texture of the vertex number 1, expected: INTEGER, received: SLASH, the line will be skipped
+	// [ERROR] line: 7, column: 4, token: '/', message: invalid token after vertex number 1, expected: SPACE, received: SLASH, the line will be skipped
+}
+
+// Reads all vertices and faces from a file with Windows line ends, a byte order mark and line continuations.
+// Check the testdata/output/windows_output.txt file for information about errors and warnings!
+func ExampleParser_Next_lineEnds() {
+	input, err := os.Open("testdata/windows.obj")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = input.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	output, err := os.Create("testdata/output/windows_output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = output.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var parser = NewParser(input)
+	parser.Output(output)
+	var elementType, element = parser.Next()
+	for elementType != EndOfFile {
+		fmt.Printf("%d %s : %v\n", parser.Line()+1, elementType, element)
+		elementType, element = parser.Next()
+	}
+	// Output:
+	//2 vertex : &{0 0 0 0 <nil>}
+	//3 vertex : &{1 0 0 0 <nil>}
+	//5 vertex : &{1 1 0 1 <nil>}
+	//7 face : &{[{1 0 0} {2 0 0} {3 0 0}]}
+	//10 vertex normal : &{0 0 1}
+}
+
+// Collects the diagnostics of a file containing errors, an unsupported format and an unclosed surface.
+func ExampleParser_Handle() {
+	var (
+		parser = NewBlockParser(NewParser(strings.NewReader(
+			"v 1 2 3\nv 1 2 x\nbevel on\n3 4\nsurf 0 1 0 1 1 2 3 4\nf 1 2 3\n",
+		)))
+		collector Collector
+	)
+	parser.Output(nil)
+	parser.Handle(collector.Handle)
+	var elementType, _ = parser.Next()
+	for elementType != EndOfFile {
+		elementType, _ = parser.Next()
+	}
+	for _, d := range collector.Diagnostics {
+		fmt.Printf(
+			"%s, line: %d, column: %d, token: %q, element: %s, message: %s\n",
+			d.Severity,
+			d.Line,
+			d.Column,
+			d.Token,
+			d.ElementType,
+			d.Message,
+		)
+	}
+	// Output:
+	//ERROR, line: 2, column: 7, token: "x", element: vertex, message: invalid Z coordinate, expected: FLOAT, received: WORD
+	//WARNING, line: 3, column: 1, token: "bevel", element: bevel interpolation, message: unsupported element format - bevel interpolation
+	//ERROR, line: 4, column: 1, token: "3", element: unknown element, message: error in the name of the element type
+	//ERROR, line: 5, column: 0, token: "", element: surface, message: the end statement of the surface is not specified, the element will be skipped
+}
+
+// Reads the same lines in different strictness modes and with the maximum number of errors.
+func ExampleParser_SetStrictness() {
+	const input = "v 1 2 3 0.5 0.25\nv 1 2 3 1 0 0 0 1\nf 1 2 3 \nf 1 2\nvt 1 2 3 4\nf 3 2 1\n"
+	var run = func(strictness Strictness, maxErrors int) {
+		var parser = NewParser(strings.NewReader(input))
+		parser.Output(nil)
+		parser.SetStrictness(strictness)
+		parser.SetMaxErrors(maxErrors)
+		var elementType, element = parser.Next()
+		for elementType != EndOfFile {
+			fmt.Printf("%s : %v\n", elementType, element)
+			elementType, element = parser.Next()
+		}
+		fmt.Println("error:", parser.Err())
+	}
+	run(Normal, 0)
+	run(Strict, 0)
+	run(Lenient, 0)
+	run(Normal, 2)
+	// Output:
+	//face : &{[{3 0 0} {2 0 0} {1 0 0}]}
+	//error: <nil>
+	//error: the parsing is aborted at line 1, the strict mode does not allow errors and warnings (errors: 1, warnings: 0): parameter blue component is not specified
+	//vertex : &{1 2 3 0.5 <nil>}
+	//vertex : &{1 2 3 1 {0 0 0}}
+	//face : &{[{1 0 0} {2 0 0} {3 0 0}]}
+	//face : &{[{3 0 0} {2 0 0} {1 0 0}]}
+	//error: <nil>
+	//error: the parsing is aborted at line 2, the maximum number of errors is reached (errors: 2, warnings: 0): unexpected token received after describing a vertex - INTEGER
+}
+
+// Repeats the line the specified number of times.
+type repeatReader struct {
+	line  string // The repeated line.
+	count int    // The number of lines left.
+	pos   int    // The position in the current line.
+}
+
+// Implementation of the Read method in the io.Reader interface.
+func (r *repeatReader) Read(p []byte) (int, error) {
+	var n = 0
+	for n < len(p) && r.count > 0 {
+		var copied = copy(p[n:], r.line[r.pos:])
+		n += copied
+		r.pos += copied
+		if r.pos == len(r.line) {
+			r.pos = 0
+			r.count--
+		}
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// Skipping a million unsupported, malformed and comment lines must not grow the stack.
+func TestParser_Next_millionSkippedLines(t *testing.T) {
+	const count = 1000000
+	// With the recursive skipping, a million lines need hundreds of megabytes of the stack.
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+	var parser = NewParser(io.MultiReader(
+		&repeatReader{line: "bevel on\n# comment\nv 1 x 3\n", count: count},
+		strings.NewReader("v 1 2 3\n"),
+	))
+	var collector Collector
+	parser.Output(nil)
+	parser.Handle(collector.Handle)
+	var elementType, element = parser.Next()
+	if elementType != Vertex {
+		t.Fatalf("got element: %s, want: %s", elementType, Vertex)
+	}
+	if v := element.(*types.Vertex); v.X != 1 || v.Y != 2 || v.Z != 3 {
+		t.Errorf("got vertex: %v, want: &{1 2 3 0}", v)
+	}
+	if elementType, _ = parser.Next(); elementType != EndOfFile {
+		t.Errorf("got element: %s, want: %s", elementType, EndOfFile)
+	}
+	if got := collector.Count(Warning); got != count {
+		t.Errorf("got warnings: %d, want: %d", got, count)
+	}
+	if got := collector.Count(Error); got != count {
+		t.Errorf("got errors: %d, want: %d", got, count)
+	}
+}
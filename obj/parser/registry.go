@@ -1,51 +1,184 @@
 package parser
 
-import "computer_graphics/obj/parser/types"
+import (
+	"computer_graphics/obj/parser/types"
+	"computer_graphics/obj/scanner"
+	"fmt"
+	"strings"
+)
 
 // A registry of parsers for each type of element in the .obj file.
 // To add support for the new model description format, you need to implement a parser for this element
 // and put this parser in the registry.
 // The parser index in the registry must match the value of the ElementType constant corresponding to the element type.
 // Look at the comments on the lines of the registry.
-var parsersRegistry = [...]elementParser{
-	buildParser(Vertex, types.NewVertex()), // Vertex
-	nil,                                    // VertexTexture
-	nil,                                    // VertexNormal
-	nil,                                    // VertexParameter
-	nil,                                    // CurveSurfaceType
-	nil,                                    // Degree
-	nil,                                    // BasisMatrix
-	nil,                                    // Step
-	nil,                                    // Point
-	nil,                                    // Line
-	buildParser(Face, types.NewFace()),     // Face
-	nil,                                    // Curve
-	nil,                                    // Curve2D
-	nil,                                    // Surface
-	nil,                                    // Parameter
-	nil,                                    // Trim
-	nil,                                    // Hole
-	nil,                                    // SpecialCurve
-	nil,                                    // SpecialPoint
-	nil,                                    // End
-	nil,                                    // Connect
-	nil,                                    // Group
-	nil,                                    // SmoothingGroup
-	nil,                                    // MergingGroup
-	nil,                                    // Object
-	nil,                                    // BevelInterpolation
-	nil,                                    // ColorInterpolation
-	nil,                                    // DissolveInterpolation
-	nil,                                    // LevelOfDetail
-	nil,                                    // MapLibrary
-	nil,                                    // UseMapping
-	nil,                                    // UseMaterial
-	nil,                                    // MaterialLibrary
-	nil,                                    // ShadowObject
-	nil,                                    // TraceObject
-	nil,                                    // CurveApproximation
-	nil,                                    // SurfaceApproximation
-	nil,                                    // Call
-	nil,                                    // Scmp
-	nil,                                    // Csh
+// The parsers of the elements registered by the Register function are appended to it.
+var parsersRegistry = []elementParser{
+	newElementParser(Vertex, types.NewVertex()),                     // Vertex
+	newElementParser(VertexTexture, types.NewVertexTexture()),       // VertexTexture
+	newElementParser(VertexNormal, types.NewVertexNormal()),         // VertexNormal
+	newElementParser(VertexParameter, types.NewVertexParameter()),   // VertexParameter
+	newElementParser(CurveSurfaceType, types.NewCurveSurfaceType()), // CurveSurfaceType
+	newElementParser(Degree, types.NewDegree()),                     // Degree
+	newElementParser(BasisMatrix, types.NewBasisMatrix()),           // BasisMatrix
+	newElementParser(Step, types.NewStep()),                         // Step
+	newElementParser(Point, types.NewPoint()),                       // Point
+	newElementParser(Line, types.NewLine()),                         // Line
+	newElementParser(Face, types.NewFace()),                         // Face
+	newElementParser(Curve, types.NewCurve()),                       // Curve
+	newElementParser(Curve2D, types.NewCurve2D()),                   // Curve2D
+	newElementParser(Surface, types.NewSurface()),                   // Surface
+	newElementParser(Parameter, types.NewParameter()),               // Parameter
+	newElementParser(Trim, types.NewTrim()),                         // Trim
+	newElementParser(Hole, types.NewHole()),                         // Hole
+	newElementParser(SpecialCurve, types.NewSpecialCurve()),         // SpecialCurve
+	newElementParser(SpecialPoint, types.NewSpecialPoint()),         // SpecialPoint
+	newElementParser(End, types.NewEnd()),                           // End
+	newElementParser(Connect, types.NewConnect()),                   // Connect
+	newElementParser(Group, types.NewGroup()),                       // Group
+	newElementParser(SmoothingGroup, types.NewSmoothingGroup()),     // SmoothingGroup
+	newElementParser(MergingGroup, types.NewMergingGroup()),         // MergingGroup
+	newElementParser(Object, types.NewObject()),                     // Object
+	nil, // BevelInterpolation
+	nil, // ColorInterpolation
+	nil, // DissolveInterpolation
+	nil, // LevelOfDetail
+	newElementParser(MapLibrary, types.NewMapLibrary()),           // MapLibrary
+	newElementParser(UseMapping, types.NewUseMapping()),           // UseMapping
+	newElementParser(UseMaterial, types.NewUseMaterial()),         // UseMaterial
+	newElementParser(MaterialLibrary, types.NewMaterialLibrary()), // MaterialLibrary
+	nil, // ShadowObject
+	nil, // TraceObject
+	newElementParser(CurveApproximation, types.NewCurveApproximation()),     // CurveApproximation
+	newElementParser(SurfaceApproximation, types.NewSurfaceApproximation()), // SurfaceApproximation
+	newNameParser(Call, convertCall),                                        // Call
+	nil,                                                                     // Scmp
+	nil,                                                                     // Csh
 }
+
+// Returns the generated elementParser of the element type if there is one,
+// otherwise builds the elementParser based on the structure of the element.
+func newElementParser(elementType ElementType, element interface{}) elementParser {
+	if newParser, ok := generatedParsers[elementType]; ok {
+		return newParser()
+	}
+	return buildParser(elementType, element)
+}
+
+// Parsers that replace the parsers of the registry in the Lenient mode.
+// They accept the common deviations from the specification.
+var lenientParsersRegistry = map[ElementType]elementParser{
+	Vertex: newLineParser(Vertex, convertLenientVertex),
+}
+
+// true if at least one statement written in a comment is registered,
+// so the Parser has to read the comments at the beginning of the lines.
+var commentStatementsRegistered = false
+
+// Returns the name of the statement written in the comment: the '#' character and the word following it.
+func commentStatementName(comment string) string {
+	if i := strings.IndexAny(comment, " \t"); i >= 0 {
+		return comment[:i]
+	}
+	return comment
+}
+
+// Returns true if the comment contains a registered statement.
+func isCommentStatement(comment string) bool {
+	var _, ok = elementDeclarationsMap[commentStatementName(comment)]
+	return ok
+}
+
+// Returns true if the name can be the name of a statement: a word that can be preceded by the '#' character.
+func validStatementName(name string) bool {
+	return isWord(strings.TrimPrefix(name, "#"))
+}
+
+// Registers a statement that is not described by the specification of .obj files, such as the vendor extensions.
+// Returns the new ElementType, under which the Parser returns the elements read from the statements with this name.
+//
+// The name is the first word of the statement, such as "vc".
+// If the name starts with the '#' character, such as "#MRGB", the statement is written in a comment,
+// so that the programs that do not support it skip it.
+//
+// The element must be a pointer to a structure or bool, the Parser returns the elements of the same type.
+// The line is read based on the fields of the structure and their name, optional, delimiter, min, enum, union, flag, with and text tags
+// in the same way as the statements of the types package, see their declarations for examples.
+//
+// Returns an error if the name is not a word, the statement with the same name is already declared,
+// or the structure does not satisfy the limitations of the tags.
+// The function is not safe for concurrent use with the parsers, so the statements should be registered
+// before reading the files, for example, in the init function.
+func Register(name string, element interface{}) (elementType ElementType, err error) {
+	if !validStatementName(name) {
+		return UnknownElement, fmt.Errorf("invalid statement name - %q", name)
+	}
+	if _, ok := elementDeclarationsMap[name]; ok {
+		return UnknownElement, fmt.Errorf("the statement %s is already declared", name)
+	}
+	if len(elementsMap) > 255 {
+		return UnknownElement, fmt.Errorf("too many registered statements, the statement %s cannot be registered", name)
+	}
+	// The name of the element type is used in the messages of the built parser.
+	elementType = ElementType(len(elementsMap))
+	elementsMap = append(elementsMap, name)
+	// The builder panics if the structure does not satisfy the limitations.
+	defer func() {
+		if r := recover(); r != nil {
+			elementsMap = elementsMap[:elementType]
+			elementType, err = UnknownElement, fmt.Errorf("the statement %s cannot be registered: %v", name, r)
+		}
+	}()
+	if element == nil {
+		panic("the element must be a pointer to a struct or bool")
+	}
+	var p = buildParser(elementType, element)
+	for len(parsersRegistry) < int(elementType) {
+		parsersRegistry = append(parsersRegistry, nil)
+	}
+	parsersRegistry = append(parsersRegistry, p)
+	elementDeclarationsMap[name] = elementType
+	elementNamesMap[elementType] = name
+	if strings.HasPrefix(name, "#") {
+		commentStatementsRegistered = true
+	}
+	return elementType, nil
+}
+
+// Reads the statement written in a comment from the text of the comment following the name of the statement.
+// The positions of the tokens are reported relative to the line of the file containing the comment.
+type commentStatementScanner struct {
+	scanner.Scanner                 // Reads the text of the comment following the name of the statement.
+	file            scanner.Scanner // The scanner of the file that has read the comment.
+	offset          int             // The position of the text of the comment following the name in the line of the file.
+}
+
+// Creates a new commentStatementScanner that reads the comment read by the scanner of the file
+// starting from the character following the name of the statement of the specified length.
+// The comment ends with the end of the line, as the line containing it.
+func newCommentStatementScanner(file scanner.Scanner, comment string, nameLength int) *commentStatementScanner {
+	return &commentStatementScanner{
+		Scanner: scanner.NewScanner(strings.NewReader(comment[nameLength:] + "\n")),
+		file:    file,
+		offset:  len(file.LineString()) - len(comment) + nameLength,
+	}
+}
+
+// Implementation of the SkipLine method in the Scanner interface.
+// Skips the rest of the comment and the rest of the line of the file.
+func (s *commentStatementScanner) SkipLine() {
+	s.Scanner.SkipLine()
+	s.file.SkipLine()
+}
+
+// Implementation of the LineString method in the Scanner interface.
+func (s *commentStatementScanner) LineString() string { return s.file.LineString() }
+
+// Implementation of the Line method in the Scanner interface.
+func (s *commentStatementScanner) Line() int { return s.file.Line() }
+
+// Implementation of the Column method in the Scanner interface.
+func (s *commentStatementScanner) Column() int { return s.Scanner.Column() + s.offset }
+
+// Implementation of the Err method in the Scanner interface.
+func (s *commentStatementScanner) Err() error { return s.file.Err() }
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The color of a vertex written by a vendor extension: vc index r g b.
+type vertexColor struct {
+	Index int     `name:"vertex index"`
+	R     float64 `name:"red component"`
+	G     float64 `name:"green component"`
+	B     float64 `name:"blue component"`
+}
+
+// The colors of the vertices written in a comment: #MRGB block1 block2 ...
+type polypaint struct {
+	Blocks []string `name:"color block" min:"1"`
+}
+
+// Reads the statements of the vendor extensions.
+func ExampleRegister() {
+	var vc, err = Register("vc", &vertexColor{})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	var mrgb ElementType
+	if mrgb, err = Register("#MRGB", &polypaint{}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	var parser = NewParser(strings.NewReader(strings.Join([]string{
+		"v 0 0 0",
+		"vc 1 1 0.5 0 # orange",
+		"# An ordinary comment.",
+		"#MRGB ff808080 ff00ff00",
+		"#MRGB",
+		"vc 2 red",
+	}, "\n") + "\n"))
+	parser.Output(nil)
+	parser.Handle(func(d Diagnostic) { fmt.Println(d) })
+	for elementType, element := parser.Next(); elementType != EndOfFile; elementType, element = parser.Next() {
+		fmt.Printf("%s: %v\n", elementType, element)
+	}
+	fmt.Println(Format(vc, &vertexColor{Index: 3, R: 0.25}))
+	fmt.Println(Format(mrgb, &polypaint{Blocks: []string{"ff000000"}}))
+	_, err = Register("v", &vertexColor{})
+	fmt.Println(err)
+	_, err = Register("vx", &struct{ Colors map[string]float64 }{})
+	fmt.Println(err)
+	// Output:
+	//vertex: &{0 0 0 0 <nil>}
+	//vc: &{1 1 0.5 0}
+	//#MRGB: &{[ff808080 ff00ff00]}
+	//[ERROR] line: 5, column: 6, token: 'eol', message: all parameters of the #MRGB are not specified, the line will be skipped
+	//[ERROR] line: 6, column: 6, token: 'red', message: invalid red component, expected: FLOAT, received: WORD, the line will be skipped
+	//vc 3 0.25 0 0 <nil>
+	//#MRGB ff000000 <nil>
+	//the statement v is already declared
+	//the statement vx cannot be registered: unsupported struct field type: map
+}
@@ -0,0 +1,237 @@
+package types
+
+// One of the possible types of free-form curves and surfaces.
+type FreeFormType uint8
+
+const (
+	Bmatrix  FreeFormType = iota // Basis matrix.
+	Bezier                       // Bezier.
+	Bspline                      // B-spline.
+	Cardinal                     // Cardinal.
+	Taylor                       // Taylor.
+)
+
+// Specifies a parameter space vertex.
+type VertexParameter struct {
+	U float64 `name:"u coordinate"`                     // The point in the parameter space of a curve or the first coordinate in the parameter space of a surface.
+	V float64 `name:"v coordinate" optional:"true"`     // The second coordinate in the parameter space of a surface.
+	W float64 `name:"weight parameter" optional:"true"` // Weight required for rational trimming curves.
+}
+
+// Creates a new parameter space vertex.
+func NewVertexParameter() *VertexParameter {
+	return &VertexParameter{}
+}
+
+// Specifies the type of curve or surface and indicates a rational or non-rational form.
+type CurveSurfaceType struct {
+	Rational bool         `name:"rational form" flag:"rat"`                                            // true if the curve or surface is rational.
+	Type     FreeFormType `name:"curve or surface type" enum:"bmatrix,bezier,bspline,cardinal,taylor"` // The type of the curve or surface.
+}
+
+// Creates a new curve or surface type.
+func NewCurveSurfaceType() *CurveSurfaceType {
+	return &CurveSurfaceType{}
+}
+
+// Specifies the degree of the curve or surface.
+type Degree struct {
+	U int `name:"degree in the u direction"`                 // The degree in the u direction.
+	V int `name:"degree in the v direction" optional:"true"` // The degree in the v direction, required only for surfaces.
+}
+
+// Creates a new degree.
+func NewDegree() *Degree {
+	return &Degree{}
+}
+
+// Specifies the basis matrix used for basis matrix curves and surfaces.
+type BasisMatrix struct {
+	Direction DirectionType `name:"direction"`      // The direction to which the matrix applies.
+	Matrix    []float64     `name:"matrix" min:"1"` // The values of the matrix in row-major order.
+}
+
+// Creates a new basis matrix.
+func NewBasisMatrix() *BasisMatrix {
+	return &BasisMatrix{}
+}
+
+// Specifies the step size for curves and surfaces that use a basis matrix.
+type Step struct {
+	U int `name:"step size in the u direction"`                 // The step size in the u direction.
+	V int `name:"step size in the v direction" optional:"true"` // The step size in the v direction, required only for surfaces.
+}
+
+// Creates a new step.
+func NewStep() *Step {
+	return &Step{}
+}
+
+// Specifies a curve, its parameter range and its control vertices.
+type Curve struct {
+	Start    float64 `name:"starting parameter value"` // The starting parameter value for the curve.
+	End      float64 `name:"ending parameter value"`   // The ending parameter value for the curve.
+	Vertices []int   `name:"control vertex" min:"2"`   // Reference numbers for the control vertices.
+}
+
+// Creates a new curve.
+func NewCurve() *Curve {
+	return &Curve{}
+}
+
+// Specifies a 2D curve on a surface and its control points.
+type Curve2D struct {
+	Vertices []int `name:"control point" min:"2"` // Reference numbers for the control points in the parameter space.
+}
+
+// Creates a new 2D curve.
+func NewCurve2D() *Curve2D {
+	return &Curve2D{}
+}
+
+// Specifies a surface, its parameter range and its control vertices.
+type Surface struct {
+	StartU float64 `name:"starting parameter value in the u direction"` // The starting parameter value in the u direction.
+	EndU   float64 `name:"ending parameter value in the u direction"`   // The ending parameter value in the u direction.
+	StartV float64 `name:"starting parameter value in the v direction"` // The starting parameter value in the v direction.
+	EndV   float64 `name:"ending parameter value in the v direction"`   // The ending parameter value in the v direction.
+	// Contains information about all control vertices of the surface.
+	Vertices []struct {
+		Index   int `name:"index"`                   // Reference number for the control vertex.
+		Texture int `name:"texture" optional:"true"` // Reference number for the texture vertex.
+		Normal  int `name:"normal" optional:"true"`  // Reference number for the vertex normal.
+	} `name:"control vertex" delimiter:"slash" min:"4"`
+}
+
+// Creates a new surface.
+func NewSurface() *Surface {
+	return &Surface{}
+}
+
+// Specifies the global parameter values in one direction of a curve or surface.
+type Parameter struct {
+	Direction DirectionType `name:"direction"`               // The direction of the parameter values.
+	Values    []float64     `name:"parameter value" min:"2"` // The parameter values (knot vector for B-splines).
+}
+
+// Creates a new parameter.
+func NewParameter() *Parameter {
+	return &Parameter{}
+}
+
+// Specifies a segment of a 2D curve that is part of a trimming loop or a special curve.
+type CurveSegment struct {
+	Start float64 `name:"starting parameter value"` // The starting parameter value for the segment of the curve.
+	End   float64 `name:"ending parameter value"`   // The ending parameter value for the segment of the curve.
+	Curve int     `name:"curve index"`              // Reference number for the 2D curve.
+}
+
+// Specifies an outer trimming loop of a surface.
+type Trim struct {
+	Segments []CurveSegment `name:"curve segment" delimiter:"space" min:"1"` // The segments of the loop.
+}
+
+// Creates a new outer trimming loop.
+func NewTrim() *Trim {
+	return &Trim{}
+}
+
+// Specifies an inner trimming loop of a surface.
+type Hole struct {
+	Segments []CurveSegment `name:"curve segment" delimiter:"space" min:"1"` // The segments of the loop.
+}
+
+// Creates a new inner trimming loop.
+func NewHole() *Hole {
+	return &Hole{}
+}
+
+// Specifies a special curve that must be included in any triangulation of a surface.
+type SpecialCurve struct {
+	Segments []CurveSegment `name:"curve segment" delimiter:"space" min:"1"` // The segments of the curve.
+}
+
+// Creates a new special curve.
+func NewSpecialCurve() *SpecialCurve {
+	return &SpecialCurve{}
+}
+
+// Specifies special points that must be included in any triangulation of a curve or surface.
+type SpecialPoint struct {
+	Vertices []int `name:"point" min:"1"` // Reference numbers for the parameter space vertices.
+}
+
+// Creates a new special point.
+func NewSpecialPoint() *SpecialPoint {
+	return &SpecialPoint{}
+}
+
+// Ends the description of a curve or surface.
+type End struct{}
+
+// Creates a new end statement.
+func NewEnd() *End {
+	return &End{}
+}
+
+// Specifies the connectivity between two surfaces.
+type Connect struct {
+	Surface1 int     `name:"first surface index"`                          // Reference number for the first surface.
+	Start1   float64 `name:"starting parameter value of the first curve"`  // The starting parameter value of the curve on the first surface.
+	End1     float64 `name:"ending parameter value of the first curve"`    // The ending parameter value of the curve on the first surface.
+	Curve1   int     `name:"first curve index"`                            // Reference number for the 2D curve on the first surface.
+	Surface2 int     `name:"second surface index"`                         // Reference number for the second surface.
+	Start2   float64 `name:"starting parameter value of the second curve"` // The starting parameter value of the curve on the second surface.
+	End2     float64 `name:"ending parameter value of the second curve"`   // The ending parameter value of the curve on the second surface.
+	Curve2   int     `name:"second curve index"`                           // Reference number for the 2D curve on the second surface.
+}
+
+// Creates a new connectivity.
+func NewConnect() *Connect {
+	return &Connect{}
+}
+
+// One of the possible techniques for approximating curves and surfaces.
+type ApproximationTechnique uint8
+
+// The constants are in the order of the words of the enum tags of the techniques.
+const (
+	ConstantParametric  ApproximationTechnique = iota // Constant parametric subdivision (cparm and cparma).
+	ConstantSpatial                                   // Constant spatial subdivision (cspace).
+	CurvatureDependent                                // Curvature-dependent subdivision (curv).
+	ConstantParametricB                               // Constant parametric subdivision with a single resolution for both directions (cparmb).
+)
+
+// Specifies the approximation technique for curves.
+type CurveApproximation struct {
+	Technique ApproximationTechnique `name:"technique" enum:"cparm,cspace,curv"` // The approximation technique.
+	// The resolution factor of the constant parametric subdivision, the maximum length of the line segments
+	// of the constant spatial subdivision or the maximum distance between the curve and the line segments
+	// of the curvature-dependent subdivision.
+	Value float64 `name:"value"`
+	// The maximum angle in degrees between the tangent vectors at the ends of the line segments
+	// of the curvature-dependent subdivision.
+	MaxAngle float64 `name:"maximum angle" optional:"true" with:"curv"`
+}
+
+// Creates a new curve approximation technique.
+func NewCurveApproximation() *CurveApproximation {
+	return &CurveApproximation{}
+}
+
+// Specifies the approximation technique for surfaces.
+type SurfaceApproximation struct {
+	Technique ApproximationTechnique `name:"technique" enum:"cparma,cspace,curv,cparmb"` // The approximation technique.
+	// The resolution factor of the constant parametric subdivision in the u direction or in both directions (cparmb),
+	// the maximum length of the edges of the constant spatial subdivision or the maximum distance
+	// between the surface and the polygons of the curvature-dependent subdivision.
+	Value float64 `name:"value"`
+	// The resolution factor of the constant parametric subdivision in the v direction (cparma) or the maximum angle
+	// in degrees between the normal vectors at the corners of the polygons of the curvature-dependent subdivision.
+	Second float64 `name:"second value" optional:"true" with:"cparma,curv"`
+}
+
+// Creates a new surface approximation technique.
+func NewSurfaceApproximation() *SurfaceApproximation {
+	return &SurfaceApproximation{}
+}
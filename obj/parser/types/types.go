@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // One of the possible direction values.
 type DirectionType uint8
 
@@ -8,12 +10,27 @@ const (
 	U                      // U direction.
 )
 
+// Describes a color in RGB format, each component usually takes values from 0 to 1.
+type Color struct {
+	R float64 `name:"red component"`   // The red component of the color.
+	G float64 `name:"green component"` // The green component of the color.
+	B float64 `name:"blue component"`  // The blue component of the color.
+}
+
+// Converts the color to a string in the format {r g b}.
+func (c *Color) String() string {
+	return fmt.Sprintf("{%v %v %v}", c.R, c.G, c.B)
+}
+
 // Specifies a geometric vertex.
+// The color is an extension written after the coordinates by photogrammetry and point cloud tools,
+// the statement with six values is read as x y z r g b and the statement with seven values as x y z w r g b.
 type Vertex struct {
-	X float64 `name:"X coordinate"`                     // X coordinate of the vertex.
-	Y float64 `name:"Y coordinate"`                     // Y coordinate of the vertex.
-	Z float64 `name:"Z coordinate"`                     // Z coordinate of the vertex.
-	W float64 `name:"weight parameter" optional:"true"` // Weight required for rational curves and surfaces.
+	X     float64 `name:"X coordinate"`                     // X coordinate of the vertex.
+	Y     float64 `name:"Y coordinate"`                     // Y coordinate of the vertex.
+	Z     float64 `name:"Z coordinate"`                     // Z coordinate of the vertex.
+	W     float64 `name:"weight parameter" optional:"true"` // Weight required for rational curves and surfaces.
+	Color *Color  `name:"color" optional:"true"`            // The color of the vertex, nil if not specified.
 }
 
 // Creates a new vertex.
@@ -21,6 +38,30 @@ func NewVertex() *Vertex {
 	return &Vertex{}
 }
 
+// Specifies a texture vertex.
+type VertexTexture struct {
+	U float64 `name:"horizontal direction"`                 // The value for the horizontal direction of the texture.
+	V float64 `name:"vertical direction" optional:"true"`   // The value for the vertical direction of the texture.
+	W float64 `name:"depth of the texture" optional:"true"` // The value for the depth of the texture.
+}
+
+// Creates a new texture vertex.
+func NewVertexTexture() *VertexTexture {
+	return &VertexTexture{}
+}
+
+// Specifies a normal vector with components i, j, and k.
+type VertexNormal struct {
+	I float64 `name:"i component"` // The i component of the normal vector.
+	J float64 `name:"j component"` // The j component of the normal vector.
+	K float64 `name:"k component"` // The k component of the normal vector.
+}
+
+// Creates a new vertex normal.
+func NewVertexNormal() *VertexNormal {
+	return &VertexNormal{}
+}
+
 // Specifies a face element.
 type Face struct {
 	// Contains information about all vertexes of the face.
@@ -35,3 +76,119 @@ type Face struct {
 func NewFace() *Face {
 	return &Face{}
 }
+
+// Specifies a point element.
+type Point struct {
+	Vertices []int `name:"vertex" min:"1"` // Reference numbers for the vertices of the points.
+}
+
+// Creates a new point.
+func NewPoint() *Point {
+	return &Point{}
+}
+
+// Specifies a line element, a polyline passing through all its vertices.
+type Line struct {
+	// Contains information about all vertexes of the line.
+	Vertices []struct {
+		Index   int `name:"index"`                   // Reference number for the vertex.
+		Texture int `name:"texture" optional:"true"` // Reference number for the texture vertex.
+	} `name:"vertex" delimiter:"slash" min:"2"`
+}
+
+// Creates a new line.
+func NewLine() *Line {
+	return &Line{}
+}
+
+// Specifies the group names for the elements that follow it.
+type Group struct {
+	Names []string `name:"group name" min:"1"` // The names of the groups the following elements belong to.
+}
+
+// Creates a new group statement.
+func NewGroup() *Group {
+	return &Group{}
+}
+
+// Sets the smoothing group for the elements that follow it.
+type SmoothingGroup struct {
+	Number int `name:"group number" union:"off=0"` // The number of the smoothing group, 0 if smoothing groups are turned off.
+}
+
+// Creates a new smoothing group statement.
+func NewSmoothingGroup() *SmoothingGroup {
+	return &SmoothingGroup{}
+}
+
+// Sets the merging group and merge resolution for the free-form surfaces that follow it.
+type MergingGroup struct {
+	Number     int     `name:"group number" union:"off=0"`               // The number of the merging group, 0 if merging groups are turned off.
+	Resolution float64 `name:"resolution" optional:"true" without:"off"` // The maximum distance between two surfaces that will be merged together.
+}
+
+// Creates a new merging group statement.
+func NewMergingGroup() *MergingGroup {
+	return &MergingGroup{}
+}
+
+// Specifies the object name for the elements that follow it.
+type Object struct {
+	Name string `name:"object name" text:"true"` // The name of the object, can contain spaces.
+}
+
+// Creates a new object statement.
+func NewObject() *Object {
+	return &Object{}
+}
+
+// Specifies the material library files for the material statements.
+type MaterialLibrary struct {
+	Files []string `name:"file name" min:"1"` // The names of the .mtl files.
+}
+
+// Creates a new material library statement.
+func NewMaterialLibrary() *MaterialLibrary {
+	return &MaterialLibrary{}
+}
+
+// Specifies the material name for the elements that follow it.
+type UseMaterial struct {
+	Name string `name:"material name"` // The name of the material.
+}
+
+// Creates a new material statement.
+func NewUseMaterial() *UseMaterial {
+	return &UseMaterial{}
+}
+
+// Specifies the texture map library files for the mapping statements.
+type MapLibrary struct {
+	Files []string `name:"file name" min:"1"` // The names of the texture map library files.
+}
+
+// Creates a new map library statement.
+func NewMapLibrary() *MapLibrary {
+	return &MapLibrary{}
+}
+
+// Specifies the texture map name for the elements that follow it.
+type UseMapping struct {
+	Name string `name:"map name"` // The name of the texture map, "off" if texture mapping is turned off.
+}
+
+// Creates a new mapping statement.
+func NewUseMapping() *UseMapping {
+	return &UseMapping{}
+}
+
+// Reads the contents of another .obj file at the point of the statement.
+type Call struct {
+	File      string   // The name of the file to be read.
+	Arguments []string // The arguments substituted into the file instead of $1, $2 and so on.
+}
+
+// Creates a new call statement.
+func NewCall() *Call {
+	return &Call{}
+}
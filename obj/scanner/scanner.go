@@ -9,8 +9,8 @@ type TokenType uint8
 
 const (
 	Word    TokenType = iota // Can consist of letters, numbers, and underscores. Cannot start with a number.
-	Integer                  // Consists of digits. Can start with a minus.
-	Float                    // Consists of digits with a dot between them. Can start with a minus.
+	Integer                  // Consists of digits. Can start with a minus or a plus.
+	Float                    // Consists of digits with a dot before, between or after them and an optional exponent. Can start with a minus or a plus.
 	Slash                    // '/' character.
 	Space                    // A sequence of spaces and/or tabs.
 	EOL                      // '\n' character.
@@ -22,9 +22,22 @@ const (
 // Number of different token options.
 const TokensCount = 9
 
+// One of the possible modes in which the Scanner.Next method reads tokens.
+// In the Names and Text modes, the tokens starting with a space, the end of the line or the '#' character
+// are read in the same way as in the Tokens mode.
+type Mode uint8
+
+const (
+	Tokens Mode = iota // The tokens are read according to the descriptions of the TokenType constants.
+	Names              // Any sequence of characters except spaces and the end of the line is read as a Word.
+	Text               // The rest of the line up to a comment is read as a Word, the trailing spaces are not included.
+)
+
 // Converts the state of the finite state machine from which it moved to the initial state to the type of the read token.
 // See https://github.com/as30606552/ComputerGraphicsProject/wiki/Scanner.
-var tokenTypeMap = [...]TokenType{Unknown, Comment, EOL, Space, Slash, Unknown, Unknown, Integer, Float, Word, Unknown}
+var tokenTypeMap = [...]TokenType{
+	Unknown, Comment, EOL, Space, Slash, Unknown, Float, Integer, Float, Word, Unknown, Unknown, Unknown, Unknown, Float,
+}
 
 // Converts a token type constant to its string representation.
 var tokenTypeNamesMap = [...]string{"WORD", "INTEGER", "FLOAT", "SLASH", "SPACE", "EOL", "EOF", "UNKNOWN", "COMMENT"}
@@ -55,6 +68,14 @@ type Scanner interface {
 	IsSkipComments() bool
 	// You can use this method to enable or disable skipping comments.
 	SkipComments(skipComments bool)
+	// Returns the mode in which the Next method reads tokens.
+	Mode() Mode
+	// Sets the mode in which the Next method reads tokens.
+	// The mode remains the same until the next call of the method.
+	SetMode(mode Mode)
+	// Returns the error returned by the reader, other than io.EOF, or nil.
+	// After the error, the Scanner behaves as if all bytes have been read.
+	Err() error
 }
 
 // One of the possible states of a finite state machine.
@@ -62,32 +83,38 @@ type Scanner interface {
 type stateType uint8
 
 const (
-	start      stateType = iota // Initial state.
-	skipLine                    // Skipping all characters up to the '\n' character.
-	foundEol                    // '\n' character found.
-	foundSpace                  // Whitespace character found.
-	foundSlash                  // '/' character found.
-	foundMinus                  // '-' character was found at the beginning of the token, and a digit is expected.
-	foundDot                    // A '.' character is found after an integer, a digit is expected.
-	foundInt                    // '\n' character found.
-	foundFloat                  // A sequence of characters satisfying the Float token is found, a digit is expected.
-	foundWord                   // A sequence of characters satisfying the Word token is found.
-	unknown                     // A sequence of characters that does not match the above types.
+	start              stateType = iota // Initial state.
+	skipLine                            // Skipping all characters up to the '\n' character.
+	foundEol                            // '\n' character found.
+	foundSpace                          // Whitespace character found.
+	foundSlash                          // '/' character found.
+	foundSign                           // '-' or '+' character was found at the beginning of the token, a digit or a '.' is expected.
+	foundDot                            // A '.' character is found after an integer, the token satisfies the Float token.
+	foundInt                            // A sequence of characters satisfying the Integer token is found.
+	foundFloat                          // A sequence of characters satisfying the Float token is found, a digit is expected.
+	foundWord                           // A sequence of characters satisfying the Word token is found.
+	unknown                             // A sequence of characters that does not match the above types.
+	foundLeadingDot                     // A '.' character is found before any digit, a digit is expected.
+	foundExponent                       // An 'e' or 'E' character is found after a number, a digit or a sign is expected.
+	foundExponentSign                   // A sign of the exponent is found, a digit is expected.
+	foundExponentDigit                  // A sequence of characters satisfying the Float token with an exponent is found.
 )
 
 // One of the possible character types that can be contained in a sequence of bytes to be read.
 type symbolType uint8
 
 const (
-	eol    symbolType = iota // '\n'
-	space                    // ' ' or '\t'
-	hash                     // '#'
-	slash                    // '/'
-	minus                    // '-'
-	dot                      // '.'
-	digit                    // '0' - '9'
-	letter                   // 'a' - 'z' or 'A' - 'Z' or '_'
-	other                    // Any other character.
+	eol      symbolType = iota // '\n'
+	space                      // ' ' or '\t'
+	hash                       // '#'
+	slash                      // '/'
+	minus                      // '-'
+	dot                        // '.'
+	digit                      // '0' - '9'
+	letter                     // 'a' - 'z' or 'A' - 'Z' or '_' except 'e' and 'E'
+	other                      // Any other character.
+	plus                       // '+'
+	exponent                   // 'e' or 'E'
 )
 
 // Calculates the character type.
@@ -105,6 +132,10 @@ func getSymbolType(symbol byte) symbolType {
 		return slash
 	case '-':
 		return minus
+	case '+':
+		return plus
+	case 'e', 'E':
+		return exponent
 	case '.':
 		return dot
 	case '_':
@@ -121,21 +152,26 @@ func getSymbolType(symbol byte) symbolType {
 
 // The finite state machine table.
 // See https://github.com/as30606552/ComputerGraphicsProject/wiki/Scanner.
-var matrix = [9][11]stateType{
-	{foundEol, start, start, start, start, start, start, start, start, start, start},
-	{foundSpace, skipLine, start, foundSpace, start, start, start, start, start, start, start},
-	{skipLine, skipLine, start, start, start, start, start, start, start, start, start},
-	{foundSlash, skipLine, start, start, start, start, start, start, start, start, start},
-	{foundMinus, skipLine, start, start, start, unknown, unknown, unknown, unknown, unknown, unknown},
-	{unknown, skipLine, start, start, start, unknown, unknown, foundDot, unknown, unknown, unknown},
-	{foundInt, skipLine, start, start, start, foundInt, foundFloat, foundInt, foundFloat, foundWord, unknown},
-	{foundWord, skipLine, start, start, start, unknown, unknown, unknown, unknown, foundWord, unknown},
-	{unknown, skipLine, start, start, start, unknown, unknown, unknown, unknown, unknown, unknown},
+var matrix = [11][15]stateType{
+	{foundEol, start, start, start, start, start, start, start, start, start, start, start, start, start, start},
+	{foundSpace, skipLine, start, foundSpace, start, start, start, start, start, start, start, start, start, start, start},
+	{skipLine, skipLine, start, start, start, start, start, start, start, start, start, start, start, start, start},
+	{foundSlash, skipLine, start, start, start, start, start, start, start, start, start, start, start, start, start},
+	{foundSign, skipLine, start, start, start, unknown, unknown, unknown, unknown, unknown, unknown, unknown, foundExponentSign, unknown, unknown},
+	{foundLeadingDot, skipLine, start, start, start, foundLeadingDot, unknown, foundDot, unknown, unknown, unknown, unknown, unknown, unknown, unknown},
+	{foundInt, skipLine, start, start, start, foundInt, foundFloat, foundInt, foundFloat, foundWord, unknown, foundFloat, foundExponentDigit, foundExponentDigit, foundExponentDigit},
+	{foundWord, skipLine, start, start, start, unknown, unknown, unknown, unknown, foundWord, unknown, unknown, unknown, unknown, unknown},
+	{unknown, skipLine, start, start, start, unknown, unknown, unknown, unknown, unknown, unknown, unknown, unknown, unknown, unknown},
+	{foundSign, skipLine, start, start, start, unknown, unknown, unknown, unknown, unknown, unknown, unknown, foundExponentSign, unknown, unknown},
+	{foundWord, skipLine, start, start, start, unknown, foundExponent, foundExponent, foundExponent, foundWord, unknown, unknown, unknown, unknown, unknown},
 }
 
 // The size of the buffer in which the scanner stores the read characters.
 const bufsize uint8 = 255
 
+// The byte order mark that some editors put at the beginning of UTF-8 files.
+const bom = "\xEF\xBB\xBF"
+
 // Implements the Scanner interface.
 // Stores the scanner state and a buffer of read bytes.
 type scanner struct {
@@ -144,35 +180,49 @@ type scanner struct {
 	buffer  [bufsize]byte // Temporary storage for bytes extracted from the reader but not yet processed.
 	bufpos  uint8         // The position of the currently processed byte in the buffer.
 	buflast uint8         // The number of bytes contained in the buffer.
+	eof     bool          // true if all bytes are read from the reader to the buffer.
+	err     error         // The error returned by the reader, other than io.EOF.
 
 	lineStr      []byte // Current processed line string.
 	switchLine   bool   // true if the scanner read the string to the end.
+	continued    bool   // true if the last processed character is a line continuation.
 	lineNum      int    // The number of the currently processed line.
 	posNum       int    // The position of the currently processed character relative to the beginning of the byte sequence.
 	skipComments bool   // true if comments should be skipped.
+	mode         Mode   // The mode in which the tokens are read.
 }
 
 // Creates a new Scanner that reads from the reader.
 // Sets skipping comments by default.
+// The byte order mark at the beginning of the reader is skipped.
 func NewScanner(reader io.Reader) Scanner {
 	var scanner = scanner{reader: reader, skipComments: true}
 	// Initialization: allocating memory and filling the buffer.
-	scanner.refreshBuffer()
 	scanner.refreshLine()
 	scanner.lineNum = 0
+	if scanner.fill(len(bom)) && string(scanner.buffer[:len(bom)]) == bom {
+		scanner.bufpos += uint8(len(bom))
+		scanner.posNum += len(bom)
+	}
 	return Scanner(&scanner)
 }
 
-// Reads new values to the buffer.
-// The number of bytes read is stored in the buflast field.
-// The current bufpos is reset to 0.
-func (scanner *scanner) refreshBuffer() {
-	var n, err = scanner.reader.Read(scanner.buffer[:])
-	if err != nil && err != io.EOF {
-		panic(err)
+// Reads new values to the buffer until it contains at least n unprocessed bytes or all bytes are read.
+// The unprocessed bytes are moved to the beginning of the buffer.
+// Returns true if the buffer contains at least n unprocessed bytes.
+func (scanner *scanner) fill(n int) bool {
+	for int(scanner.buflast-scanner.bufpos) < n && !scanner.eof {
+		copy(scanner.buffer[:], scanner.buffer[scanner.bufpos:scanner.buflast])
+		scanner.buflast -= scanner.bufpos
+		scanner.bufpos = 0
+		var read, err = scanner.reader.Read(scanner.buffer[scanner.buflast:])
+		if err != nil && err != io.EOF {
+			scanner.err = err
+		}
+		scanner.buflast += uint8(read)
+		scanner.eof = err != nil
 	}
-	scanner.buflast = uint8(n)
-	scanner.bufpos = 0
+	return int(scanner.buflast-scanner.bufpos) >= n
 }
 
 // Moving the scanner to the next line.
@@ -183,46 +233,77 @@ func (scanner *scanner) refreshLine() {
 
 // Returns true if there is a next token.
 func (scanner *scanner) has() bool {
-	// The buffer is processed to the end.
-	// It is necessary to read the new data to the buffer.
-	if scanner.bufpos == scanner.buflast {
-		// If the number of types in the buffer is less than the buffer size,
-		// it means that the buffer was not fully filled the previous time when reading it.
-		if scanner.buflast < bufsize {
-			return false
-		} else {
-			scanner.refreshBuffer()
+	return scanner.fill(1)
+}
+
+// Returns the byte of the buffer at the offset from the currently processed byte and true,
+// or false if the reader does not contain so many bytes.
+func (scanner *scanner) lookahead(offset int) (byte, bool) {
+	if scanner.fill(offset + 1) {
+		return scanner.buffer[int(scanner.bufpos)+offset], true
+	}
+	return 0, false
+}
+
+// Returns the next character from the reader and the number of bytes it consists of.
+// The "\r\n" and "\r" line ends are returned as a single '\n' character.
+// A backslash at the end of the line is a line continuation, it is returned as a ' ' character and true.
+// Panics if it can't get the next character, because this method is only used if the next character is present.
+func (scanner *scanner) symbol() (byte, int, bool) {
+	var symbol, ok = scanner.lookahead(0)
+	if !ok {
+		// Impossible situation.
+		panic("cannot get the next byte")
+	}
+	switch symbol {
+	case '\r':
+		if next, ok := scanner.lookahead(1); ok && next == '\n' {
+			return '\n', 2, false
+		}
+		return '\n', 1, false
+	case '\\':
+		var next, ok = scanner.lookahead(1)
+		if ok && next == '\n' {
+			return ' ', 2, true
+		}
+		if ok && next == '\r' {
+			if next, ok = scanner.lookahead(2); ok && next == '\n' {
+				return ' ', 3, true
+			}
+			return ' ', 2, true
 		}
 	}
-	return scanner.bufpos != scanner.buflast
+	return symbol, 1, false
 }
 
 // Returns the next character from the reader.
 // Panics if it can't get the next character, because this method is only used if the next character is present.
 func (scanner *scanner) peek() byte {
-	if scanner.has() {
-		return scanner.buffer[scanner.bufpos]
-	}
-	// Impossible situation.
-	panic("cannot get the next byte")
+	var symbol, _, _ = scanner.symbol()
+	return symbol
 }
 
 // Moves to the next character.
 // Calls the peek method without checking the existence of the next character,
 // so it must only be called if the next character exists.
+// The line continuation moves the scanner to the next line, but does not end the current line string.
 func (scanner *scanner) step() {
 	if scanner.switchLine {
 		scanner.refreshLine()
 		scanner.switchLine = false
 	}
-	var symbol = scanner.peek()
-	if symbol == '\n' {
+	var symbol, size, continuation = scanner.symbol()
+	scanner.continued = continuation
+	if continuation {
+		scanner.lineStr = append(scanner.lineStr, '\\')
+		scanner.switchLine = true
+	} else if symbol == '\n' {
 		scanner.switchLine = true
 	} else {
 		scanner.lineStr = append(scanner.lineStr, symbol)
 	}
-	scanner.bufpos++
-	scanner.posNum++
+	scanner.bufpos += uint8(size)
+	scanner.posNum += size
 }
 
 // Implementation of the Next method in the Scanner interface.
@@ -237,17 +318,15 @@ func (scanner *scanner) Next() (TokenType, string) {
 		tokenType TokenType
 		buffer    = make([]byte, 0, 100) // Contains the characters that were read.
 	)
+	if scanner.mode != Tokens {
+		switch getSymbolType(scanner.peek()) {
+		case eol, space, hash:
+		default:
+			return Word, scanner.name()
+		}
+	}
 	for scanner.has() {
 		symbol = scanner.peek()
-		// Skipping the '\r' character to handle line ends on Windows
-		if symbol == '\r' {
-			if scanner.has() {
-				scanner.step()
-				symbol = scanner.peek()
-			} else {
-				symbol = '\n'
-			}
-		}
 		tokenType = tokenTypeMap[state]
 		state = matrix[getSymbolType(symbol)][state] // The next state is contained in the matrix.
 		// The transition to the start state means the end of the token.
@@ -265,9 +344,65 @@ func (scanner *scanner) Next() (TokenType, string) {
 	return tokenTypeMap[state], string(buffer)
 }
 
+// Reads a Word token in the Names or Text mode.
+func (scanner *scanner) name() string {
+	var (
+		symbol byte
+		buffer = make([]byte, 0, 100) // Contains the characters that were read.
+	)
+	for scanner.has() {
+		symbol = scanner.peek()
+		switch getSymbolType(symbol) {
+		case eol:
+			return string(buffer)
+		case space:
+			if scanner.mode == Names || scanner.textEnds() {
+				return string(buffer)
+			}
+		}
+		buffer = append(buffer, symbol)
+		scanner.step()
+	}
+	return string(buffer)
+}
+
+// Returns true if the currently processed byte starts a sequence of spaces
+// followed by the end of the line, the end of the file or a comment.
+// Only the bytes that fit into the buffer are checked, so too long sequences of spaces are considered part of the text.
+func (scanner *scanner) textEnds() bool {
+	for offset := 0; offset < int(bufsize)-2; offset++ {
+		var symbol, ok = scanner.lookahead(offset)
+		if !ok {
+			return true
+		}
+		switch symbol {
+		case ' ', '\t':
+		case '\\':
+			// A line continuation is a space, any other backslash is a part of the text.
+			var next, _ = scanner.lookahead(offset + 1)
+			switch next {
+			case '\n':
+				offset++
+			case '\r':
+				offset++
+				if next, _ = scanner.lookahead(offset + 1); next == '\n' {
+					offset++
+				}
+			default:
+				return false
+			}
+		case '\n', '\r', '#':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
 // Implementation of the SkipLine method in the Scanner interface.
 func (scanner *scanner) SkipLine() {
-	if scanner.switchLine {
+	if scanner.switchLine && !scanner.continued {
 		return
 	}
 	var symbol byte
@@ -312,3 +447,18 @@ func (scanner *scanner) IsSkipComments() bool {
 func (scanner *scanner) SkipComments(skipComments bool) {
 	scanner.skipComments = skipComments
 }
+
+// Implementation of the Mode method in the Scanner interface.
+func (scanner *scanner) Mode() Mode {
+	return scanner.mode
+}
+
+// Implementation of the SetMode method in the Scanner interface.
+func (scanner *scanner) SetMode(mode Mode) {
+	scanner.mode = mode
+}
+
+// Implementation of the Err method in the Scanner interface.
+func (scanner *scanner) Err() error {
+	return scanner.err
+}
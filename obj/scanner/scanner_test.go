@@ -1,8 +1,12 @@
 package scanner
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"testing"
+	"testing/iotest"
 )
 
 // Reading the correct data.
@@ -40,3 +44,163 @@ func ExampleScanner_Next_incorrect() {
 	//SPACE : ' '
 	//UNKNOWN : '0.0.1'
 }
+
+// Reading numbers with signs, exponents and omitted digits before or after the dot.
+func ExampleScanner_Next_numbers() {
+	var s = NewScanner(strings.NewReader("1.5e-05 +0.25 .5 3. +5 -.5 2E+3 1e5 -3.e2 end"))
+	var tokenType, token = s.Next()
+	for tokenType != EOF {
+		if tokenType != Space {
+			fmt.Printf("%s : '%s'\n", tokenType, token)
+		}
+		tokenType, token = s.Next()
+	}
+	// Output:
+	//FLOAT : '1.5e-05'
+	//FLOAT : '+0.25'
+	//FLOAT : '.5'
+	//FLOAT : '3.'
+	//INTEGER : '+5'
+	//FLOAT : '-.5'
+	//FLOAT : '2E+3'
+	//FLOAT : '1e5'
+	//FLOAT : '-3.e2'
+	//WORD : 'end'
+}
+
+// Reading incorrect numbers.
+func ExampleScanner_Next_incorrectNumbers() {
+	var s = NewScanner(strings.NewReader("1e 1e+ . +. + 1.5e-5.1 1.5ee5 +-1 .e5 e5"))
+	var tokenType, token = s.Next()
+	for tokenType != EOF {
+		if tokenType != Space {
+			fmt.Printf("%s : '%s'\n", tokenType, token)
+		}
+		tokenType, token = s.Next()
+	}
+	// Output:
+	//UNKNOWN : '1e'
+	//UNKNOWN : '1e+'
+	//UNKNOWN : '.'
+	//UNKNOWN : '+.'
+	//UNKNOWN : '+'
+	//UNKNOWN : '1.5e-5.1'
+	//UNKNOWN : '1.5ee5'
+	//UNKNOWN : '+-1'
+	//UNKNOWN : '.e5'
+	//WORD : 'e5'
+}
+
+// Reading data with Windows line ends, a byte order mark and line continuations.
+func ExampleScanner_Next_lineEnds() {
+	var s = NewScanner(strings.NewReader("\xEF\xBB\xBFv 1 2\r\nf 1 \\\r\n 2 3\\\n\r\nend\rx 1\\"))
+	var tokenType, token = s.Next()
+	for tokenType != EOF {
+		fmt.Printf("line: %d, column: %d, %s : %q\n", s.Line()+1, s.Column(), tokenType, token)
+		tokenType, token = s.Next()
+	}
+	// Output:
+	//line: 1, column: 0, WORD : "v"
+	//line: 1, column: 1, SPACE : " "
+	//line: 1, column: 2, INTEGER : "1"
+	//line: 1, column: 3, SPACE : " "
+	//line: 1, column: 4, INTEGER : "2"
+	//line: 1, column: 5, EOL : "\n"
+	//line: 2, column: 0, WORD : "f"
+	//line: 2, column: 1, SPACE : " "
+	//line: 2, column: 2, INTEGER : "1"
+	//line: 3, column: 0, SPACE : "   "
+	//line: 3, column: 1, INTEGER : "2"
+	//line: 3, column: 2, SPACE : " "
+	//line: 3, column: 3, INTEGER : "3"
+	//line: 3, column: 5, SPACE : " "
+	//line: 4, column: 0, EOL : "\n"
+	//line: 5, column: 2, WORD : "end"
+	//line: 5, column: 3, EOL : "\n"
+	//line: 6, column: 0, WORD : "x"
+	//line: 6, column: 1, SPACE : " "
+	//line: 6, column: 4, UNKNOWN : "1\\"
+}
+
+// Reads the names and the rest of the lines after the element names in the Names and Text modes.
+func ExampleScanner_Next_modes() {
+	var (
+		s     = NewScanner(strings.NewReader("mtllib ../tex/wood.mtl a-b.mtl\ng группа-1 #1 # comment\no My object  # comment\no 1.5 \\\n 2\\x\n"))
+		modes = map[string]Mode{"mtllib": Names, "g": Names, "o": Text}
+	)
+	var tokenType, token = s.Next()
+	for tokenType != EOF {
+		fmt.Printf("line: %d, %s : %q\n", s.Line()+1, tokenType, token)
+		if mode, ok := modes[token]; ok && s.Mode() == Tokens {
+			s.SetMode(mode)
+		} else if tokenType == EOL {
+			s.SetMode(Tokens)
+		}
+		tokenType, token = s.Next()
+	}
+	// Output:
+	//line: 1, WORD : "mtllib"
+	//line: 1, SPACE : " "
+	//line: 1, WORD : "../tex/wood.mtl"
+	//line: 1, SPACE : " "
+	//line: 1, WORD : "a-b.mtl"
+	//line: 1, EOL : "\n"
+	//line: 2, WORD : "g"
+	//line: 2, SPACE : " "
+	//line: 2, WORD : "группа-1"
+	//line: 2, SPACE : " "
+	//line: 2, EOL : "\n"
+	//line: 3, WORD : "o"
+	//line: 3, SPACE : " "
+	//line: 3, WORD : "My object"
+	//line: 3, SPACE : "  "
+	//line: 3, EOL : "\n"
+	//line: 4, WORD : "o"
+	//line: 4, SPACE : " "
+	//line: 5, WORD : "1.5   2\\x"
+	//line: 5, EOL : "\n"
+}
+
+// Testing that the line ends and continuations split between reads from the reader are processed correctly.
+func TestScanner_Next_oneByteReader(t *testing.T) {
+	var (
+		input    = "\xEF\xBB\xBFv 1 2\r\nf 1 \\\r\n 2 3\\\n\r\nend\rx 1\\"
+		expected = NewScanner(strings.NewReader(input))
+		got      = NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+	)
+	for {
+		var (
+			expectedType, expectedToken = expected.Next()
+			gotType, gotToken           = got.Next()
+		)
+		if gotType != expectedType || gotToken != expectedToken || got.Line() != expected.Line() {
+			t.Fatalf(
+				"got: %s %q at line %d, want: %s %q at line %d",
+				gotType, gotToken, got.Line(), expectedType, expectedToken, expected.Line(),
+			)
+		}
+		if gotType == EOF {
+			return
+		}
+	}
+}
+
+// Checks that the tokens read before the error of the reader are returned and the error is kept by the scanner.
+func TestScanner_Err(t *testing.T) {
+	var (
+		readErr = errors.New("connection reset")
+		s       = NewScanner(io.MultiReader(strings.NewReader("v 1"), iotest.ErrReader(readErr)))
+		tokens  []string
+	)
+	var tokenType, token = s.Next()
+	for tokenType != EOF {
+		tokens = append(tokens, token)
+		tokenType, token = s.Next()
+	}
+	if strings.Join(tokens, "|") != "v| |1" {
+		t.Errorf("got tokens: %q, want: %q", tokens, []string{"v", " ", "1"})
+	}
+	if s.Err() != readErr {
+		t.Errorf("got error: %v, want: %v", s.Err(), readErr)
+	}
+}
@@ -0,0 +1,235 @@
+package writer
+
+import (
+	"bufio"
+	"computer_graphics/model"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Allows you to write a model.Model to a .obj file.
+// Writes the vertices, texture vertices, normals, faces, lines and points of the model
+// and the statements of the groups, objects, smoothing groups and materials they belong to.
+// The written file is read back by the importer.Importer into the same model.
+type Writer struct {
+	// The number of digits after the decimal point of the written numbers,
+	// 0 for the shortest representation that is read back exactly.
+	Precision int
+	// If true, the comments with the number of the written elements are written before them.
+	Comments bool
+	// The name of the material library file written in the mtllib statement, the statement is not written if empty.
+	// The library can be written by the WriteMaterials function.
+	MaterialLibrary string
+}
+
+// Converts the number to the string according to the precision.
+// The trailing zeros of the fractional part are not written.
+func (wr *Writer) float(value float64) string {
+	if wr.Precision <= 0 {
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+	var res = strconv.FormatFloat(value, 'f', wr.Precision, 64)
+	res = strings.TrimRight(strings.TrimRight(res, "0"), ".")
+	if res == "-0" {
+		return "0"
+	}
+	return res
+}
+
+// Converts the numbers to the strings according to the precision and joins them with spaces.
+func (wr *Writer) floats(values ...float64) string {
+	var res = make([]string, len(values))
+	for i, value := range values {
+		res[i] = wr.float(value)
+	}
+	return strings.Join(res, " ")
+}
+
+// Writes the comment if the comments are turned on.
+func (wr *Writer) comment(w *bufio.Writer, format string, args ...interface{}) {
+	if wr.Comments {
+		fmt.Fprintf(w, "# "+format+"\n", args...)
+	}
+}
+
+// Writes the model in the .obj format to w.
+// Returns the first error that occurred while writing.
+func (wr *Writer) Write(w io.Writer, m *model.Model) error {
+	var buffer = bufio.NewWriter(w)
+	if wr.MaterialLibrary != "" {
+		fmt.Fprintf(buffer, "mtllib %s\n", wr.MaterialLibrary)
+	}
+	wr.writeVertices(buffer, m)
+	wr.writeElements(buffer, m)
+	return buffer.Flush()
+}
+
+// Writes the model in the .obj format to the file with the specified name.
+// The file is created or truncated.
+func (wr *Writer) WriteFile(name string, m *model.Model) error {
+	var file, err = os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err = wr.Write(file, m); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Writes the vertices, texture vertices and normals of the model.
+func (wr *Writer) writeVertices(w *bufio.Writer, m *model.Model) {
+	if m.VerticesCount() > 0 {
+		wr.comment(w, "vertices: %d", m.VerticesCount())
+	}
+	for i := 1; i <= m.VerticesCount(); i++ {
+		var v, _ = m.GetVertex(i)
+		if v.Color == nil {
+			fmt.Fprintf(w, "v %s\n", wr.floats(v.X, v.Y, v.Z))
+		} else {
+			fmt.Fprintf(w, "v %s\n", wr.floats(v.X, v.Y, v.Z, v.Color.R, v.Color.G, v.Color.B))
+		}
+	}
+	if m.TextureVerticesCount() > 0 {
+		wr.comment(w, "texture vertices: %d", m.TextureVerticesCount())
+	}
+	for i := 1; i <= m.TextureVerticesCount(); i++ {
+		var vt, _ = m.GetTextureVertex(i)
+		fmt.Fprintf(w, "vt %s\n", wr.floats(vt.U, vt.V, vt.W))
+	}
+	if m.NormalsCount() > 0 {
+		wr.comment(w, "normals: %d", m.NormalsCount())
+	}
+	for i := 1; i <= m.NormalsCount(); i++ {
+		var vn, _ = m.GetNormal(i)
+		fmt.Fprintf(w, "vn %s\n", wr.floats(vn.I, vn.J, vn.K))
+	}
+}
+
+// Contains the parts of the model and the material of the last written element.
+// The statements are written only when the values change.
+type attributes struct {
+	groups         []string        // The groups of the last written element.
+	object         string          // The object of the last written element.
+	smoothingGroup int             // The smoothing group of the last written face.
+	material       *model.Material // The material of the last written element.
+}
+
+// Writes the statements of the attributes of the element that differ from the attributes of the previous element.
+// The empty object and the nil material following the other ones are written as the model.NoObject and the model.NoMaterial.
+func (a *attributes) update(
+	w *bufio.Writer,
+	groups []string,
+	object string,
+	smoothingGroup int,
+	material *model.Material,
+) {
+	if object != a.object {
+		if object == "" {
+			fmt.Fprintf(w, "o %s\n", model.NoObject)
+		} else {
+			fmt.Fprintf(w, "o %s\n", object)
+		}
+		a.object = object
+	}
+	if strings.Join(groups, " ") != strings.Join(a.groups, " ") {
+		fmt.Fprintf(w, "g %s\n", strings.Join(groups, " "))
+		a.groups = groups
+	}
+	if smoothingGroup != a.smoothingGroup {
+		if smoothingGroup == 0 {
+			fmt.Fprintln(w, "s off")
+		} else {
+			fmt.Fprintf(w, "s %d\n", smoothingGroup)
+		}
+		a.smoothingGroup = smoothingGroup
+	}
+	if material != a.material {
+		if material == nil {
+			fmt.Fprintf(w, "usemtl %s\n", model.NoMaterial)
+		} else {
+			fmt.Fprintf(w, "usemtl %s\n", material.Name)
+		}
+		a.material = material
+	}
+}
+
+// Writes the faces, lines and points of the model with the statements of their attributes.
+func (wr *Writer) writeElements(w *bufio.Writer, m *model.Model) {
+	var a = attributes{groups: []string{model.DefaultGroup}}
+	if m.FacesCount() > 0 {
+		wr.comment(w, "faces: %d", m.FacesCount())
+	}
+	for i, corners := range m.FaceCorners() {
+		var f = m.GetFace(i)
+		a.update(w, f.Groups(), f.Object(), f.SmoothingGroup(), f.Material())
+		fmt.Fprintf(w, "f %s %s %s\n", corner(corners[0]), corner(corners[1]), corner(corners[2]))
+	}
+	if m.LinesCount() > 0 {
+		wr.comment(w, "lines: %d", m.LinesCount())
+	}
+	for i, vertices := range m.LineVertices() {
+		var l = m.GetLine(i)
+		a.update(w, l.Groups(), l.Object(), a.smoothingGroup, l.Material())
+		var indices = make([]string, len(vertices))
+		for j, v := range vertices {
+			indices[j] = strconv.Itoa(v)
+		}
+		fmt.Fprintf(w, "l %s\n", strings.Join(indices, " "))
+	}
+	if m.PointsCount() > 0 {
+		wr.comment(w, "points: %d", m.PointsCount())
+	}
+	for i, v := range m.PointVertices() {
+		var p = m.GetPoint(i)
+		a.update(w, p.Groups(), p.Object(), a.smoothingGroup, p.Material())
+		fmt.Fprintf(w, "p %d\n", v)
+	}
+}
+
+// Converts the corner of the face to the string in the format v, v/vt, v//vn or v/vt/vn.
+func corner(c model.Corner) string {
+	switch {
+	case c.Texture == 0 && c.Normal == 0:
+		return strconv.Itoa(c.Vertex)
+	case c.Normal == 0:
+		return fmt.Sprintf("%d/%d", c.Vertex, c.Texture)
+	case c.Texture == 0:
+		return fmt.Sprintf("%d//%d", c.Vertex, c.Normal)
+	default:
+		return fmt.Sprintf("%d/%d/%d", c.Vertex, c.Texture, c.Normal)
+	}
+}
+
+// Writes the materials in the .mtl format to w.
+// Returns the first error that occurred while writing.
+func WriteMaterials(w io.Writer, materials []*model.Material) error {
+	var (
+		buffer = bufio.NewWriter(w)
+		wr     Writer
+		color  = func(name string, c model.Color) {
+			fmt.Fprintf(buffer, "%s %s\n", name, wr.floats(c.R, c.G, c.B))
+		}
+	)
+	for i, material := range materials {
+		if i > 0 {
+			fmt.Fprintln(buffer)
+		}
+		fmt.Fprintf(buffer, "newmtl %s\n", material.Name)
+		color("Ka", material.Ambient)
+		color("Kd", material.Diffuse)
+		color("Ks", material.Specular)
+		color("Ke", material.Emissive)
+		fmt.Fprintf(buffer, "Ns %s\n", wr.float(material.SpecularExponent))
+		fmt.Fprintf(buffer, "d %s\n", wr.float(material.Dissolve))
+		fmt.Fprintf(buffer, "illum %d\n", material.Illumination)
+		if material.DiffuseMap != "" {
+			fmt.Fprintf(buffer, "map_Kd %s\n", material.DiffuseMap)
+		}
+	}
+	return buffer.Flush()
+}
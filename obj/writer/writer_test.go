@@ -0,0 +1,213 @@
+package writer
+
+import (
+	"bytes"
+	"computer_graphics/model"
+	"computer_graphics/obj/importer"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// Writes a model with all kinds of the elements and attributes.
+func ExampleWriter_Write() {
+	var m = model.NewModel()
+	m.AppendVertex(0, 0, 0)
+	m.AppendVertex(1, 0, 0)
+	m.AppendColoredVertex(1, 1, 0, model.Color{R: 1, G: 0.5, B: 0})
+	m.AppendVertex(1.0/3, 2.0/3, -0.00001)
+	m.AppendTextureVertex(0.25, 0.75, 0)
+	m.AppendNormal(0, 0, 1)
+	_ = m.AppendFace(1, 2, 3)
+	m.SetObject("part")
+	m.SetGroups("top", "side")
+	m.SetSmoothingGroup(2)
+	m.SetMaterial(&model.Material{Name: "red"})
+	_ = m.AppendFaceCorners(model.Corner{Vertex: 1, Texture: 1}, model.Corner{Vertex: 3, Texture: 1}, model.Corner{Vertex: 4, Texture: 1})
+	_ = m.AppendFaceCorners(model.Corner{Vertex: 1, Normal: 1}, model.Corner{Vertex: 2, Normal: 1}, model.Corner{Vertex: 4, Normal: 1})
+	m.SetGroups("guides")
+	_ = m.AppendLine(1, 2, 3)
+	_ = m.AppendPoint(4)
+	var wr = Writer{Precision: 4, Comments: true, MaterialLibrary: "part.mtl"}
+	if err := wr.Write(os.Stdout, m); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// mtllib part.mtl
+	// # vertices: 4
+	// v 0 0 0
+	// v 1 0 0
+	// v 1 1 0 1 0.5 0
+	// v 0.3333 0.6667 0
+	// # texture vertices: 1
+	// vt 0.25 0.75 0
+	// # normals: 1
+	// vn 0 0 1
+	// # faces: 3
+	// f 1 2 3
+	// o part
+	// g top side
+	// s 2
+	// usemtl red
+	// f 1/1 3/1 4/1
+	// f 1//1 2//1 4//1
+	// # lines: 1
+	// g guides
+	// l 1 2 3
+	// # points: 1
+	// p 4
+}
+
+// Returns true if the models have the same elements with the same attributes.
+func equal(a, b *model.Model) bool {
+	if a.VerticesCount() != b.VerticesCount() || a.FacesCount() != b.FacesCount() ||
+		a.TextureVerticesCount() != b.TextureVerticesCount() || a.NormalsCount() != b.NormalsCount() {
+		return false
+	}
+	for i := 1; i <= a.VerticesCount(); i++ {
+		var va, _ = a.GetVertex(i)
+		var vb, _ = b.GetVertex(i)
+		if va.X != vb.X || va.Y != vb.Y || va.Z != vb.Z {
+			return false
+		}
+	}
+	for i := 1; i <= a.TextureVerticesCount(); i++ {
+		var ta, _ = a.GetTextureVertex(i)
+		var tb, _ = b.GetTextureVertex(i)
+		if ta != tb {
+			return false
+		}
+	}
+	var ca, cb = a.FaceCorners(), b.FaceCorners()
+	for i := range ca {
+		var fa, fb = a.GetFace(i), b.GetFace(i)
+		if ca[i] != cb[i] || fa.Object() != fb.Object() || fa.SmoothingGroup() != fb.SmoothingGroup() ||
+			fmt.Sprint(fa.Groups()) != fmt.Sprint(fb.Groups()) || !sameMaterial(fa.Material(), fb.Material()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if both materials are nil or have the same name.
+func sameMaterial(a, b *model.Material) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Name == b.Name
+}
+
+// Transforms the fox model, writes it and reads it back.
+func ExampleWriter_Write_roundTrip() {
+	var (
+		ipt    = importer.Importer{}
+		m, err = ipt.ImportFile("../../examples/testdata/fox.obj")
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.Rotate(math.Pi/3, math.Pi/4, 0)
+	m.Shift(0.1, 0.2, 0.3)
+	var (
+		buffer bytes.Buffer
+		wr     = Writer{MaterialLibrary: "low-poly-fox-by-pixelmannen.mtl"}
+	)
+	if err := wr.Write(&buffer, m); err != nil {
+		fmt.Println(err)
+		return
+	}
+	ipt = importer.Importer{Directory: "../../examples/testdata", Output: os.Stdout, IgnoreInfos: true}
+	var read = ipt.Import(&buffer)
+	fmt.Println("vertices:", read.VerticesCount(), "faces:", read.FacesCount())
+	fmt.Println("equal:", equal(m, read))
+	// Output:
+	// vertices: 290 faces: 576
+	// equal: true
+}
+
+// Writes the faces without an object and a material following the faces of an object with a material and reads them back.
+func ExampleWriter_Write_reset() {
+	var directory, err = os.MkdirTemp("", "writer")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer os.RemoveAll(directory)
+	var (
+		red     = &model.Material{Name: "red", Dissolve: 1}
+		library bytes.Buffer
+	)
+	if err = WriteMaterials(&library, []*model.Material{red}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err = os.WriteFile(filepath.Join(directory, "red.mtl"), library.Bytes(), 0o644); err != nil {
+		fmt.Println(err)
+		return
+	}
+	var m = model.NewModel()
+	m.AppendVertex(0, 0, 0)
+	m.AppendVertex(1, 0, 0)
+	m.AppendVertex(0, 1, 0)
+	m.SetObject("part")
+	m.SetMaterial(red)
+	_ = m.AppendFace(1, 2, 3)
+	m.SetObject("")
+	m.SetMaterial(nil)
+	_ = m.AppendFace(3, 2, 1)
+	var (
+		buffer bytes.Buffer
+		wr     = Writer{MaterialLibrary: "red.mtl"}
+	)
+	if err = wr.Write(io.MultiWriter(&buffer, os.Stdout), m); err != nil {
+		fmt.Println(err)
+		return
+	}
+	var ipt = importer.Importer{Directory: directory, Output: os.Stdout, IgnoreInfos: true}
+	fmt.Println("equal:", equal(m, ipt.Import(&buffer)))
+	// Output:
+	// mtllib red.mtl
+	// v 0 0 0
+	// v 1 0 0
+	// v 0 1 0
+	// o part
+	// usemtl red
+	// f 1 2 3
+	// o (null)
+	// usemtl (null)
+	// f 3 2 1
+	// equal: true
+}
+
+// Writes the material library for the materials of the model.
+func ExampleWriteMaterials() {
+	var materials = []*model.Material{
+		{Name: "red", Diffuse: model.Color{R: 1}, Specular: model.Color{R: 0.5, G: 0.5, B: 0.5}, Dissolve: 1, Illumination: 2},
+		{Name: "wood", Diffuse: model.Color{R: 0.8, G: 0.8, B: 0.8}, Dissolve: 0.5, DiffuseMap: "wood.png"},
+	}
+	if err := WriteMaterials(os.Stdout, materials); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// newmtl red
+	// Ka 0 0 0
+	// Kd 1 0 0
+	// Ks 0.5 0.5 0.5
+	// Ke 0 0 0
+	// Ns 0
+	// d 1
+	// illum 2
+	//
+	// newmtl wood
+	// Ka 0 0 0
+	// Kd 0.8 0.8 0.8
+	// Ks 0 0 0
+	// Ke 0 0 0
+	// Ns 0
+	// d 0.5
+	// illum 0
+	// map_Kd wood.png
+}
@@ -90,15 +90,43 @@ func (img *Image) Height() int {
 // Takes 2 points coordinates (x0, y0), (x1, y1) and line color (rgb) as input.
 // Draw a line by Bresenham algorithm.
 func (img *Image) Line(x1, y1, x2, y2 int, rgb RGB) {
+	bresenham(x1, y1, x2, y2, func(x, y int, _ float64) {
+		img.Set(x, y, rgb)
+	})
+}
+
+// Line drawing method with the depth test.
+// Takes 2 points coordinates with their depths (x1, y1, z1), (x2, y2, z2), the depth buffer and line color (rgb) as input.
+// The depth buffer is indexed as buffer[x][y], a smaller depth means that the pixel is closer.
+// A pixel is drawn only if its interpolated depth is not greater than the depth in the buffer,
+// after that the depth in the buffer is updated. Pixels outside the buffer are skipped.
+func (img *Image) DepthLine(x1, y1 int, z1 float64, x2, y2 int, z2 float64, buffer [][]float64, rgb RGB) {
+	bresenham(x1, y1, x2, y2, func(x, y int, t float64) {
+		if x < 0 || x >= len(buffer) || y < 0 || y >= len(buffer[x]) {
+			return
+		}
+		var z = z1 + (z2-z1)*t
+		if z <= buffer[x][y] {
+			img.Set(x, y, rgb)
+			buffer[x][y] = z
+		}
+	})
+}
+
+// Calls the plot function for each pixel of the line from (x1, y1) to (x2, y2) by Bresenham algorithm.
+// The plot function also takes the position of the pixel on the line, from 0 at (x1, y1) to 1 at (x2, y2).
+func bresenham(x1, y1, x2, y2 int, plot func(x, y int, t float64)) {
 	var steep = false
 	if math.Abs(float64(x1-x2)) < math.Abs(float64(y1-y2)) {
 		x1, y1 = y1, x1
 		x2, y2 = y2, x2
 		steep = true
 	}
+	var reversed = false
 	if x1 > x2 {
 		x1, x2 = x2, x1
 		y1, y2 = y2, y1
+		reversed = true
 	}
 	var (
 		deltaX          = x2 - x1
@@ -106,13 +134,20 @@ func (img *Image) Line(x1, y1, x2, y2 int, rgb RGB) {
 		deltaInaccuracy = math.Abs(float64(deltaY) / float64(deltaX))
 		inaccuracy      = 0.0
 		y               = y1
+		t               float64
 	)
 	// Calculate the y-axis offset relative to the center of the pixel at each step.
 	for x := x1; x <= x2; x++ {
+		if deltaX > 0 {
+			t = float64(x-x1) / float64(deltaX)
+		}
+		if reversed {
+			t = 1 - t
+		}
 		if steep {
-			img.Set(y, x, rgb)
+			plot(y, x, t)
 		} else {
-			img.Set(x, y, rgb)
+			plot(x, y, t)
 		}
 		inaccuracy += deltaInaccuracy
 		if inaccuracy > 0.5 {
@@ -2,6 +2,7 @@ package pngimage
 
 import (
 	"image/color"
+	"math"
 	"math/rand"
 )
 
@@ -27,6 +28,15 @@ func (rgb RGB) ToRGBA() color.RGBA {
 	}
 }
 
+// Creates RGB color from components taking values from 0 to 1.
+// Values outside this range are clamped to it.
+func NewRGB(r, g, b float64) RGB {
+	var component = func(value float64) uint8 {
+		return uint8(math.Round(255 * math.Max(0, math.Min(1, value))))
+	}
+	return RGB{R: component(r), G: component(g), B: component(b)}
+}
+
 // Creates black RGB color.
 func BlackColor() RGB {
 	return RGB{R: 0, G: 0, B: 0}